@@ -0,0 +1,3 @@
+data "nexus_blobstore_google" "gcs" {
+  name = "blobstore-google"
+}
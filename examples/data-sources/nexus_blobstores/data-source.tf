@@ -0,0 +1,5 @@
+data "nexus_blobstores" "all" {}
+
+output "blobstores_violating_quota" {
+  value = [for bs in data.nexus_blobstores.all.items : bs.name if bs.quota_violated]
+}
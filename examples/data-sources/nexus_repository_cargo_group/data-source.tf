@@ -0,0 +1,3 @@
+data "nexus_repository_cargo_group" "group" {
+  name = "cargo-group"
+}
@@ -0,0 +1,3 @@
+data "nexus_repository_cargo_hosted" "internal" {
+  name = "cargo-internal"
+}
@@ -0,0 +1,3 @@
+data "nexus_repository_huggingface_proxy" "huggingface" {
+  name = "huggingface"
+}
@@ -0,0 +1,8 @@
+data "nexus_repository_signing_key" "apt" {
+  keypair    = file("signing-key.asc")
+  passphrase = "passphrase"
+}
+
+output "apt_public_key" {
+  value = data.nexus_repository_signing_key.apt.public_key
+}
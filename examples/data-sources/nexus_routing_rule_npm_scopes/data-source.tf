@@ -0,0 +1,65 @@
+data "nexus_routing_rule_npm_scopes" "company" {
+  scopes = ["@company"]
+}
+
+# Never fetch packages of the company scope from npmjs.org
+resource "nexus_routing_rule" "npm_company_scope" {
+  name        = "npm-company-scope"
+  description = "Block @company packages"
+  mode        = "BLOCK"
+  matchers    = data.nexus_routing_rule_npm_scopes.company.matchers
+}
+
+resource "nexus_repository_npm_proxy" "npmjs" {
+  name         = "npmjs"
+  online       = true
+  routing_rule = nexus_routing_rule.npm_company_scope.name
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url = "https://registry.npmjs.org"
+  }
+
+  negative_cache {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+}
+
+resource "nexus_repository_npm_hosted" "company" {
+  name   = "npm-company"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW_ONCE"
+  }
+}
+
+# @company packages are served by the hosted repository only
+resource "nexus_repository_npm_group" "npm" {
+  name   = "npm"
+  online = true
+
+  group {
+    member_names = [
+      nexus_repository_npm_hosted.company.name,
+      nexus_repository_npm_proxy.npmjs.name,
+    ]
+  }
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+}
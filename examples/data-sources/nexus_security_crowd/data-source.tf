@@ -0,0 +1 @@
+data "nexus_security_crowd" "crowd" {}
@@ -15,3 +15,16 @@ resource "nexus_blobstore_azure" "example" {
     type  = "spaceRemainingQuota"
   }
 }
+
+# Use the managed identity of the Nexus host, e.g. on AKS
+resource "nexus_blobstore_azure" "managed_identity" {
+  name = "managed-identity"
+
+  bucket_configuration {
+    account_name = "example-account-name"
+    authentication {
+      authentication_method = "MANAGEDIDENTITY"
+    }
+    container_name = "example-container-name"
+  }
+}
@@ -0,0 +1,21 @@
+resource "nexus_blobstore_google" "gcs" {
+  name = "blobstore-google"
+
+  bucket_configuration {
+    bucket {
+      name   = "gcs-bucket-name"
+      region = "europe-west3"
+      prefix = "nexus"
+    }
+
+    bucket_security {
+      authentication_method = "accountKey"
+      account_key           = file("service-account.json")
+    }
+  }
+
+  soft_quota {
+    limit = 1024
+    type  = "spaceRemainingQuota"
+  }
+}
@@ -6,3 +6,15 @@ resource "nexus_blobstore_group" "example" {
     nexus_blobstore_file.two.name
   ]
 }
+
+# Promote the existing blobstore "default", which is used by repositories, to a group.
+# It is renamed to "default-promoted" and becomes a member of the group.
+resource "nexus_blobstore_group" "promoted" {
+  name            = "default"
+  fill_policy     = "writeToFirst"
+  promoted_member = "default-promoted"
+  members = [
+    "default-promoted",
+    nexus_blobstore_file.one.name
+  ]
+}
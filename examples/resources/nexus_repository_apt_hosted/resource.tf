@@ -4,7 +4,7 @@ resource "nexus_repository_apt_hosted" "bullseye_stable" {
 
   distribution = "bullseye"
   signing {
-    keypair    = "keypair"
+    keypair    = file("signing-key.asc")
     passphrase = "passphrase"
   }
 
@@ -42,7 +42,7 @@ resource "nexus_repository_bower_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_bower_hosted.internal.name,
       nexus_repository_bower_proxy.bower_io.name,
     ]
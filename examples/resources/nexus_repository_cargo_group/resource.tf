@@ -0,0 +1,16 @@
+resource "nexus_repository_cargo_group" "group" {
+  name   = "cargo-group"
+  online = true
+
+  group {
+    members = [
+      nexus_repository_cargo_hosted.internal.name,
+      nexus_repository_cargo_proxy.crates_io.name,
+    ]
+  }
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+}
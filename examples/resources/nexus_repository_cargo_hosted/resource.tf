@@ -0,0 +1,10 @@
+resource "nexus_repository_cargo_hosted" "internal" {
+  name   = "cargo-internal"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW_ONCE"
+  }
+}
@@ -0,0 +1,25 @@
+resource "nexus_repository_cargo_proxy" "crates_io" {
+  name   = "crates-io"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url       = "https://index.crates.io/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+}
@@ -23,3 +23,33 @@ resource "nexus_repository_conan_proxy" "conan_center" {
     auto_block = true
   }
 }
+
+resource "nexus_repository_conan_proxy" "conan_center_v2" {
+  name   = "conan-center-v2"
+  online = true
+
+  conan_proxy {
+    conan_version = "V2"
+  }
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url       = "https://center2.conan.io"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+}
@@ -59,7 +59,7 @@ resource "nexus_repository_docker_group" "group" {
   }
 
   group {
-    member_names = [
+    members = [
       nexus_repository_docker_hosted.internal.name,
       nexus_repository_docker_proxy.dockerhub.name
     ]
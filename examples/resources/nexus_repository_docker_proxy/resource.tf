@@ -8,7 +8,12 @@ resource "nexus_repository_docker_proxy" "dockerhub" {
   }
 
   docker_proxy {
-    index_type = "HUB"
+    index_type           = "HUB"
+    cache_foreign_layers = true
+    foreign_layer_url_whitelist = [
+      "https://go.microsoft.com/.*",
+      "https://mcr.microsoft.com/.*",
+    ]
   }
 
   storage {
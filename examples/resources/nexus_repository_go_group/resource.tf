@@ -30,7 +30,7 @@ resource "nexus_repository_go_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_go_proxy.golang_org.name,
     ]
   }
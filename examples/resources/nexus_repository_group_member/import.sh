@@ -0,0 +1,2 @@
+# import using the name of the group repository and the name of the member separated by a slash
+terraform import nexus_repository_group_member.team_a maven-public/team-a-releases
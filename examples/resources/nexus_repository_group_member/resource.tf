@@ -0,0 +1,26 @@
+resource "nexus_repository_maven_group" "public" {
+  name = "maven-public"
+
+  group {
+    members = [
+      "maven-central",
+    ]
+  }
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  lifecycle {
+    ignore_changes = [group]
+  }
+}
+
+# Example Usage - Add a repository owned by a team module to the shared group,
+# so it is searched before Maven Central
+resource "nexus_repository_group_member" "team_a" {
+  group    = nexus_repository_maven_group.public.name
+  member   = "team-a-releases"
+  position = 0
+}
@@ -21,7 +21,7 @@ resource "nexus_repository_maven_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_maven_hosted.releases.name,
     ]
   }
@@ -14,7 +14,7 @@ resource "nexus_repository_npm_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_npm_hosted.internal.name,
     ]
   }
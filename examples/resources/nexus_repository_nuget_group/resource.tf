@@ -43,7 +43,7 @@ resource "nexus_repository_nuget_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_nuget_hosted.internal.name,
       nexus_repository_nuget_proxy.nuget_org.name,
     ]
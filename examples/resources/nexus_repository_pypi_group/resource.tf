@@ -40,7 +40,7 @@ resource "nexus_repository_pypi_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_pypi_hosted.internal.name,
       nexus_repository_pypi_proxy.pypi_org.name,
     ]
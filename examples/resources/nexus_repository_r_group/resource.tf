@@ -40,7 +40,7 @@ resource "nexus_repository_r_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_r_hosted.internal.name,
       nexus_repository_r_proxy.r_org.name,
     ]
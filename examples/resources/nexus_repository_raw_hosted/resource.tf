@@ -7,4 +7,8 @@ resource "nexus_repository_raw_hosted" "internal" {
     strict_content_type_validation = false
     write_policy                   = "ALLOW"
   }
+
+  raw {
+    content_disposition = "INLINE"
+  }
 }
@@ -40,7 +40,7 @@ resource "nexus_repository_rubygems_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_rubygems_hosted.internal.name,
       nexus_repository_rubygems_proxy.rubygems_org.name,
     ]
@@ -0,0 +1,32 @@
+resource "nexus_repository_raw_proxy" "raw_org" {
+  name                  = "raw-org"
+  ignore_status_changes = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url       = "https://repo1.raw.org/raw2/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+}
+
+resource "nexus_repository_status" "raw_org" {
+  name       = nexus_repository_raw_proxy.raw_org.name
+  online     = false
+  blocked    = true
+  auto_block = false
+}
@@ -16,7 +16,7 @@ resource "nexus_repository_yum_group" "group" {
   online = true
 
   group {
-    member_names = [
+    members = [
       nexus_repository_yum_hosted.internal.name,
     ]
   }
@@ -0,0 +1,2 @@
+# import of crowd configuration
+terraform import nexus_security_crowd.example crowd
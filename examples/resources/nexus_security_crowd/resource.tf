@@ -0,0 +1,14 @@
+resource "nexus_security_crowd" "example" {
+  url                  = "https://crowd.example.com"
+  application_name     = "nexus"
+  application_password = "crowd-application-password"
+  timeout              = 15000
+  use_trust_store      = true
+  verify_connection    = true
+}
+
+resource "nexus_security_realms" "example" {
+  active = ["NexusAuthenticatingRealm", "NexusAuthorizingRealm", "Crowd"]
+
+  depends_on = [nexus_security_crowd.example]
+}
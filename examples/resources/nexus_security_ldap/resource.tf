@@ -27,4 +27,11 @@ resource "nexus_security_ldap" "example" {
   user_password_attribute        = "exmaple"
   user_real_name_attribute       = "cn"
   user_subtree                   = true
+
+  verify {
+    connection     = true
+    user_mapping   = true
+    login_username = "jdoe"
+    login_password = "t3stpassw0rd"
+  }
 }
@@ -2,5 +2,7 @@ resource "nexus_security_realms" "example" {
   active = [
     "NexusAuthenticatingRealm",
     "NexusAuthorizingRealm",
+    # Lets npm clients authenticate with `npm login` against npm repositories
+    "NpmToken",
   ]
 }
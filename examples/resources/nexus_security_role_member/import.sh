@@ -0,0 +1,2 @@
+# import using the roleid of the security role and the child roleid separated by a slash
+terraform import nexus_security_role_member.team_a developers/team-a-developers
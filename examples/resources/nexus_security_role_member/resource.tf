@@ -0,0 +1,15 @@
+resource "nexus_security_role" "developers" {
+  roleid      = "developers"
+  name        = "developers"
+  description = "Shared developer role"
+
+  lifecycle {
+    ignore_changes = [roles]
+  }
+}
+
+# Example Usage - Add a role owned by a team module to the shared role
+resource "nexus_security_role_member" "team_a" {
+  roleid        = nexus_security_role.developers.roleid
+  member_roleid = "team-a-developers"
+}
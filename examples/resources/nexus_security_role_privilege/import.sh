@@ -0,0 +1,2 @@
+# import using the roleid of the security role and the privilege name separated by a slash
+terraform import nexus_security_role_privilege.team_a_maven_browse developers/nx-repository-view-maven2-team-a-releases-browse
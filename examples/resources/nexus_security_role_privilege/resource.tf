@@ -0,0 +1,15 @@
+resource "nexus_security_role" "developers" {
+  roleid      = "developers"
+  name        = "developers"
+  description = "Shared developer role"
+
+  lifecycle {
+    ignore_changes = [privileges]
+  }
+}
+
+# Example Usage - Add a privilege owned by a team module to the shared role
+resource "nexus_security_role_privilege" "team_a_maven_browse" {
+  roleid    = nexus_security_role.developers.roleid
+  privilege = "nx-repository-view-maven2-team-a-releases-browse"
+}
@@ -7,3 +7,15 @@ resource "nexus_security_user" "admin" {
   roles     = ["nx-admin"]
   status    = "active"
 }
+
+# Example Usage - Rotate a password without storing it in the state
+resource "nexus_security_user" "ci" {
+  userid              = "ci"
+  firstname           = "Continuous"
+  lastname            = "Integration"
+  email               = "ci@example.com"
+  password_wo         = var.ci_password
+  password_wo_version = 2
+  roles               = ["nx-admin"]
+  status              = "active"
+}
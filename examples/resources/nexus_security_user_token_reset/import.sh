@@ -0,0 +1,2 @@
+# import using the id of the user
+terraform import nexus_security_user_token_reset.ci ci
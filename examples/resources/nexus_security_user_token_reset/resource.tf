@@ -0,0 +1,14 @@
+resource "nexus_security_user_token" "enabled" {
+  enabled = true
+}
+
+# Example Usage - Reset the user token of the CI user every time the rotation date changes
+resource "nexus_security_user_token_reset" "ci" {
+  userid = "ci"
+
+  keepers = {
+    rotation = "2022-10-01"
+  }
+
+  depends_on = [nexus_security_user_token.enabled]
+}
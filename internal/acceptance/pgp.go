@@ -0,0 +1,18 @@
+package acceptance
+
+import (
+	_ "embed"
+)
+
+// TestPGPKeypair is a passphrase protected PGP key pair to sign the metadata
+// of apt and yum repositories in acceptance tests. The tests of the tools
+// package read the same file.
+//
+//go:embed testdata/pgp-private-key.asc
+var TestPGPKeypair string
+
+const (
+	TestPGPPassphrase  = "test-passphrase"
+	TestPGPFingerprint = "84F20A2BF84A8CAD587B526358785B3F8341C981"
+	TestPGPKeyID       = "58785B3F8341C981"
+)
@@ -5,7 +5,8 @@ const (
 resource "nexus_repository_apt_hosted" "acceptance" {
 	distribution = "{{ .Apt.Distribution }}"
 	signing {
-		keypair = "{{ .AptSigning.Keypair }}"
+		keypair = <<EOT
+{{ .AptSigning.Keypair }}EOT
 {{- if .AptSigning.Passphrase }}
 		passphrase = "{{ .AptSigning.Passphrase }}"
 {{- end }}
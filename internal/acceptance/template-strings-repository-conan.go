@@ -1,7 +1,16 @@
 package acceptance
 
 const (
+	TemplateStringRepositoryConanHosted = `
+resource "nexus_repository_conan_hosted" "acceptance" {
+` + TemplateStringHostedRepository
+
 	TemplateStringRepositoryConanProxy = `
 resource "nexus_repository_conan_proxy" "acceptance" {
+{{- if .ConanProxy }}
+	conan_proxy {
+		conan_version = "{{ .ConanProxy.ConanVersion }}"
+	}
+{{- end }}
 ` + TemplateStringProxyRepository
 )
@@ -49,6 +49,14 @@ resource "nexus_repository_docker_proxy" "acceptance" {
 		index_type = "{{ .DockerProxy.IndexType }}"
 {{- if .DockerProxy.IndexURL }}
 		index_url = "{{ .DockerProxy.IndexURL }}"
+{{- end }}
+		cache_foreign_layers = "{{ .DockerProxy.CacheForeignLayers }}"
+{{- if .DockerProxy.ForeignLayerURLWhitelist }}
+		foreign_layer_url_whitelist = [
+		{{- range $val := .DockerProxy.ForeignLayerURLWhitelist }}
+			"{{ $val }}",
+		{{ end -}}
+		]
 {{- end }}
 	}
 ` + TemplateStringProxyRepository
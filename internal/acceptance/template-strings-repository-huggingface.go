@@ -0,0 +1,7 @@
+package acceptance
+
+const (
+	TemplateStringRepositoryHuggingFaceProxy = `
+resource "nexus_repository_huggingface_proxy" "acceptance" {
+` + TemplateStringProxyRepository
+)
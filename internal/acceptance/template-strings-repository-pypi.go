@@ -10,7 +10,7 @@ resource "nexus_repository_pypi_group" "acceptance" {
 	depends_on = [
 		nexus_repository_pypi_hosted.acceptance
 	]
-` + TemplateStringGroupRepository
+` + TemplateStringGroupDeployRepository
 
 	TemplateStringRepositoryPypiProxy = `
 resource "nexus_repository_pypi_proxy" "acceptance" {
@@ -10,7 +10,7 @@ resource "nexus_repository_r_group" "acceptance" {
 	depends_on = [
 		nexus_repository_r_hosted.acceptance
 	]
-` + TemplateStringGroupRepository
+` + TemplateStringGroupDeployRepository
 
 	TemplateStringRepositoryRProxy = `
 resource "nexus_repository_r_proxy" "acceptance" {
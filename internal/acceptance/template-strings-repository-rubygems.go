@@ -10,7 +10,7 @@ resource "nexus_repository_rubygems_group" "acceptance" {
 	depends_on = [
 		nexus_repository_rubygems_hosted.acceptance
 	]
-` + TemplateStringGroupRepository
+` + TemplateStringGroupDeployRepository
 
 	TemplateStringRepositoryRubygemsProxy = `
 resource "nexus_repository_rubygems_proxy" "acceptance" {
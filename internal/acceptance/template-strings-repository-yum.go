@@ -13,7 +13,8 @@ resource "nexus_repository_yum_hosted" "acceptance" {
 resource "nexus_repository_yum_group" "acceptance" {
 {{ if .YumSigning }}
 	yum_signing {
-		keypair = "{{ .YumSigning.Keypair }}"
+		keypair = <<EOT
+{{ .YumSigning.Keypair }}EOT
 {{ if .YumSigning.Passphrase }}
 		passphrase = "{{ .YumSigning.Passphrase }}"
 {{ end -}}
@@ -28,7 +29,8 @@ resource "nexus_repository_yum_group" "acceptance" {
 resource "nexus_repository_yum_proxy" "acceptance" {
 {{ if .YumSigning }}
 	yum_signing {
-		keypair = "{{ .YumSigning.Keypair }}"
+		keypair = <<EOT
+{{ .YumSigning.Keypair }}EOT
 {{ if .YumSigning.Passphrase }}
 		passphrase = "{{ .YumSigning.Passphrase }}"
 {{ end -}}
@@ -0,0 +1,128 @@
+package acceptance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+)
+
+const (
+	providerAddress = "registry.terraform.io/datadrivers/nexus"
+	planFile        = "tfplan"
+	stateFile       = "terraform.tfstate"
+)
+
+// WorkingDir runs the terraform CLI against the provider, which is served in
+// the test process. Unlike resource.Test it gives access to the plan and state
+// exactly as written by Terraform.
+type WorkingDir struct {
+	t        *testing.T
+	dir      string
+	path     string
+	reattach string
+	applied  bool
+}
+
+// NewWorkingDir returns a WorkingDir in a temporary directory. The terraform
+// binary is taken from TF_ACC_TERRAFORM_PATH or PATH. All applied resources
+// are destroyed when the test finishes.
+func NewWorkingDir(t *testing.T) *WorkingDir {
+	t.Helper()
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+
+	path := os.Getenv("TF_ACC_TERRAFORM_PATH")
+	if path == "" {
+		var err error
+		if path, err = exec.LookPath("terraform"); err != nil {
+			t.Fatalf("terraform binary not found, set TF_ACC_TERRAFORM_PATH: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config, closeCh, err := plugin.DebugServe(ctx, &plugin.ServeOpts{
+		ProviderFunc: provider.Provider,
+	})
+	if err != nil {
+		cancel()
+		t.Fatalf("could not serve provider: %v", err)
+	}
+	reattach, err := json.Marshal(map[string]plugin.ReattachConfig{providerAddress: config})
+	if err != nil {
+		cancel()
+		t.Fatalf("could not marshal reattach config: %v", err)
+	}
+
+	wd := &WorkingDir{
+		t:        t,
+		dir:      t.TempDir(),
+		path:     path,
+		reattach: string(reattach),
+	}
+	t.Cleanup(func() {
+		if wd.applied {
+			wd.run("destroy", "-auto-approve", "-input=false", "-no-color")
+		}
+		cancel()
+		<-closeCh
+	})
+	return wd
+}
+
+// Plan writes the configuration, initializes the directory and returns the
+// saved plan as JSON
+func (wd *WorkingDir) Plan(config string) []byte {
+	wd.t.Helper()
+	config = `
+terraform {
+	required_providers {
+		nexus = {
+			source = "` + providerAddress + `"
+		}
+	}
+}
+` + config
+	if err := os.WriteFile(filepath.Join(wd.dir, "main.tf"), []byte(config), 0o600); err != nil {
+		wd.t.Fatalf("could not write configuration: %v", err)
+	}
+
+	wd.run("init", "-input=false", "-no-color")
+	wd.run("plan", "-input=false", "-no-color", "-out="+planFile)
+	return wd.run("show", "-json", "-no-color", planFile)
+}
+
+// Apply applies the plan of the last call of Plan and returns the state file
+func (wd *WorkingDir) Apply() []byte {
+	wd.t.Helper()
+	wd.applied = true
+	wd.run("apply", "-input=false", "-no-color", planFile)
+
+	state, err := os.ReadFile(filepath.Join(wd.dir, stateFile))
+	if err != nil {
+		wd.t.Fatalf("could not read state: %v", err)
+	}
+	return state
+}
+
+func (wd *WorkingDir) run(args ...string) []byte {
+	wd.t.Helper()
+	cmd := exec.Command(wd.path, args...)
+	cmd.Dir = wd.dir
+	cmd.Env = append(os.Environ(), "TF_REATTACH_PROVIDERS="+wd.reattach)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		wd.t.Fatalf("terraform %s failed: %v\n%s%s", args[0], err, out, stderr.String())
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package acceptance
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// serverVersionRegexp matches the version in the Server header Nexus sends
+// with every response, e.g. "Nexus/3.40.0-03 (OSS)"
+var serverVersionRegexp = regexp.MustCompile(`Nexus/([0-9.]+)`)
+
+// SkipIfNexusVersionBelow skips the test if the Nexus server is older than the
+// given version, e.g. for repository formats added in later releases
+func SkipIfNexusVersionBelow(t *testing.T, minimum string) {
+	version, err := getNexusVersion()
+	if err != nil {
+		t.Fatalf("could not determine Nexus version: %v", err)
+	}
+	if compareVersions(version, minimum) < 0 {
+		t.Skipf("Nexus %s does not support this feature, it requires at least Nexus %s", version, minimum)
+	}
+}
+
+func getNexusVersion() (string, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(os.Getenv("NEXUS_URL"), "/")+"/service/rest/v1/status", nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(os.Getenv("NEXUS_USERNAME"), os.Getenv("NEXUS_PASSWORD"))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	match := serverVersionRegexp.FindStringSubmatch(resp.Header.Get("Server"))
+	if match == nil {
+		return "", fmt.Errorf("unexpected Server header '%s'", resp.Header.Get("Server"))
+	}
+	return strings.TrimSuffix(match[1], "."), nil
+}
+
+// compareVersions compares two dotted versions numerically
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
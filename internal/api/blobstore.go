@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	blobstoresAPIEndpoint = client.BasePath + "v1/blobstores"
+)
+
+// BlobstoreQuotaStatus contains the result of the soft quota check of a blob
+// store
+type BlobstoreQuotaStatus struct {
+	IsViolation   bool   `json:"isViolation"`
+	Message       string `json:"message"`
+	BlobStoreName string `json:"blobStoreName"`
+}
+
+// GetBlobstoreQuotaStatus returns whether the blob store violates its soft quota
+func (c *Client) GetBlobstoreQuotaStatus(ctx context.Context, name string) (*BlobstoreQuotaStatus, error) {
+	endpoint := fmt.Sprintf("%s/%s/quota-status", blobstoresAPIEndpoint, url.PathEscape(name))
+	body, resp, err := c.execute(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get quota status of blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var status BlobstoreQuotaStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("could not unmarshal blobstore quota status: %v", err)
+	}
+	return &status, nil
+}
+
+// ConvertBlobstoreToGroup promotes the given blob store to a group blob store
+// of the same name. The original blob store is renamed to newNameForOriginal
+// and becomes the only member of the group, so repositories using it keep
+// working.
+func (c *Client) ConvertBlobstoreToGroup(ctx context.Context, name string, newNameForOriginal string) error {
+	endpoint := fmt.Sprintf("%s/group/convert/%s/%s", blobstoresAPIEndpoint, url.PathEscape(name), url.PathEscape(newNameForOriginal))
+	body, resp, err := c.execute(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not convert blobstore '%s' to a group: HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const (
+	blobstoreGoogleAPIEndpoint = blobstoresAPIEndpoint + "/google"
+
+	GoogleAuthenticationMethodAccountKey         = "accountKey"
+	GoogleAuthenticationMethodApplicationDefault = "applicationDefault"
+)
+
+// GoogleBlobstore contains the configuration of a Google Cloud Storage blob store
+type GoogleBlobstore struct {
+	Name                string                    `json:"name"`
+	SoftQuota           *blobstore.SoftQuota      `json:"softQuota,omitempty"`
+	BucketConfiguration GoogleBucketConfiguration `json:"bucketConfiguration"`
+}
+
+// GoogleBucketConfiguration contains the bucket settings of a Google Cloud
+// Storage blob store
+type GoogleBucketConfiguration struct {
+	Bucket         GoogleBucket          `json:"bucket"`
+	BucketSecurity *GoogleBucketSecurity `json:"bucketSecurity,omitempty"`
+}
+
+// GoogleBucket identifies the bucket of a Google Cloud Storage blob store
+type GoogleBucket struct {
+	Name   string `json:"name"`
+	Prefix string `json:"prefix,omitempty"`
+	Region string `json:"region,omitempty"`
+}
+
+// GoogleBucketSecurity contains the credentials to access the bucket
+type GoogleBucketSecurity struct {
+	AuthenticationMethod string `json:"authenticationMethod"`
+	AccountKey           string `json:"accountKey,omitempty"`
+}
+
+func blobstoreGoogleEndpoint(name string) string {
+	return fmt.Sprintf("%s/%s", blobstoreGoogleAPIEndpoint, url.PathEscape(name))
+}
+
+// CreateGoogleBlobstore creates a new Google Cloud Storage blob store
+func (c *Client) CreateGoogleBlobstore(ctx context.Context, bs GoogleBlobstore) error {
+	body, resp, err := c.execute(ctx, http.MethodPost, blobstoreGoogleAPIEndpoint, bs)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not create google blobstore '%s': HTTP: %d, %s", bs.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// GetGoogleBlobstore returns the Google Cloud Storage blob store with the given
+// name or nil if it does not exist
+func (c *Client) GetGoogleBlobstore(ctx context.Context, name string) (*GoogleBlobstore, error) {
+	body, resp, err := c.execute(ctx, http.MethodGet, blobstoreGoogleEndpoint(name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get google blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var bs GoogleBlobstore
+	if err := json.Unmarshal(body, &bs); err != nil {
+		return nil, fmt.Errorf("could not unmarshal google blobstore: %v", err)
+	}
+	return &bs, nil
+}
+
+// UpdateGoogleBlobstore updates the Google Cloud Storage blob store with the
+// given name
+func (c *Client) UpdateGoogleBlobstore(ctx context.Context, name string, bs GoogleBlobstore) error {
+	body, resp, err := c.execute(ctx, http.MethodPut, blobstoreGoogleEndpoint(name), bs)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update google blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
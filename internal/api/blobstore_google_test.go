@@ -0,0 +1,60 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGoogleBlobstore(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest/v1/blobstores/google", r.URL.Path)
+
+		var bs map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&bs))
+		assert.Equal(t, map[string]interface{}{
+			"name": "gcs",
+			"bucketConfiguration": map[string]interface{}{
+				"bucket": map[string]interface{}{
+					"name":   "nexus",
+					"region": "europe-west3",
+				},
+				"bucketSecurity": map[string]interface{}{
+					"authenticationMethod": "accountKey",
+					"accountKey":           "{}",
+				},
+			},
+		}, bs)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	assert.NoError(t, c.CreateGoogleBlobstore(context.Background(), api.GoogleBlobstore{
+		Name: "gcs",
+		BucketConfiguration: api.GoogleBucketConfiguration{
+			Bucket: api.GoogleBucket{
+				Name:   "nexus",
+				Region: "europe-west3",
+			},
+			BucketSecurity: &api.GoogleBucketSecurity{
+				AuthenticationMethod: api.GoogleAuthenticationMethodAccountKey,
+				AccountKey:           "{}",
+			},
+		},
+	}))
+}
+
+func TestGetGoogleBlobstoreNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/blobstores/google/gcs", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	bs, err := c.GetGoogleBlobstore(context.Background(), "gcs")
+	assert.NoError(t, err)
+	assert.Nil(t, bs)
+}
@@ -0,0 +1,41 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertBlobstoreToGroup(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest/v1/blobstores/group/convert/default/default-promoted", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	assert.NoError(t, c.ConvertBlobstoreToGroup(context.Background(), "default", "default-promoted"))
+}
+
+func TestConvertBlobstoreToGroupError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Blob store is already a group"))
+	})
+
+	assert.ErrorContains(t, c.ConvertBlobstoreToGroup(context.Background(), "default", "default-promoted"), "already a group")
+}
+
+func TestGetBlobstoreQuotaStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/service/rest/v1/blobstores/default/quota-status", r.URL.Path)
+		w.Write([]byte(`{"isViolation":true,"message":"Blob store default is using 12 MB space and has 4 MB remaining space","blobStoreName":"default"}`))
+	})
+
+	status, err := c.GetBlobstoreQuotaStatus(context.Background(), "default")
+	assert.NoError(t, err)
+	assert.True(t, status.IsViolation)
+	assert.Equal(t, "default", status.BlobStoreName)
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+const (
+	securityAPIEndpoint = client.BasePath + "v1/security"
+)
+
+// httpClients holds the http client per configured NexusClient. The provider
+// meta data is the NexusClient, so this is one entry per provider instance.
+var httpClients sync.Map
+
+// errNotConfigured is returned by all API calls of a NexusClient, which was not
+// set up with Configure
+var errNotConfigured = errors.New("the Nexus API client is not configured")
+
+// Client gives access to Nexus API endpoints which are not supported by
+// go-nexus-client (yet). It shares the connection of the configured NexusClient.
+type Client struct {
+	client     *client.Client
+	httpClient *http.Client
+}
+
+// Configure sets up the http client used for API calls of the given
+// NexusClient. go-nexus-client keeps its own http client, so only the calls of
+// Client are traced.
+func Configure(nexusClient *nexus.NexusClient, config client.Config) {
+	httpClients.Store(nexusClient, newHTTPClient(config))
+}
+
+// NewClient returns a Client for the provider meta data. All API calls fail if
+// the NexusClient was not set up with Configure.
+func NewClient(m interface{}) *Client {
+	nexusClient := m.(*nexus.NexusClient)
+
+	// NexusClient does not export its http client, but all of its services
+	// share the same configuration for building requests
+	c := &Client{
+		client: nexusClient.BlobStore.Client,
+	}
+	if httpClient, ok := httpClients.Load(nexusClient); ok {
+		c.httpClient = httpClient.(*http.Client)
+	}
+	return c
+}
+
+// newHTTPClient returns a http client with the same settings go-nexus-client
+// uses, which traces all requests and responses to the Nexus API log subsystem
+func newHTTPClient(config client.Config) *http.Client {
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: logging.NewSubsystemLoggingHTTPTransport(LogSubsystem, &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: config.Insecure,
+			},
+		}),
+	}
+}
+
+func (c *Client) execute(ctx context.Context, method string, endpoint string, data interface{}) ([]byte, *http.Response, error) {
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete:
+	default:
+		return nil, nil, fmt.Errorf("unsupported HTTP method %s", method)
+	}
+
+	var payload io.Reader
+	if data != nil {
+		ioReader, err := tools.JsonMarshalInterfaceToIOReader(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		payload = ioReader
+	}
+
+	if c.httpClient == nil {
+		return nil, nil, errNotConfigured
+	}
+
+	req, err := c.client.NewRequest(method, endpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(NewLogContext(ctx)))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	return body, resp, err
+}
@@ -0,0 +1,34 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *api.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}
+	nexusClient := nexus.NewClient(config)
+	api.Configure(nexusClient, config)
+	return api.NewClient(nexusClient)
+}
+
+func TestNewClientNotConfigured(t *testing.T) {
+	c := api.NewClient(nexus.NewClient(client.Config{URL: "http://127.0.0.1:1"}))
+
+	_, err := c.GetRepositorySettings(context.Background(), "maven-releases")
+	assert.EqualError(t, err, "the Nexus API client is not configured")
+}
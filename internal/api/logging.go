@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem which traces the Nexus API requests
+	// and responses of Client. Requests of go-nexus-client are not traced, as
+	// its http client cannot be configured.
+	LogSubsystem = "nexus_api"
+)
+
+var (
+	// logSensitiveHeaders are the HTTP headers which carry credentials
+	logSensitiveHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Proxy-Authorization",
+		"Set-Cookie",
+	}
+
+	// logSensitiveJSONMembers matches the members of Nexus API payloads which
+	// carry secrets
+	logSensitiveJSONMembers = regexp.MustCompile(`"(accountKey|applicationPassword|authPassword|keypair|nameCode|passCode|passphrase|password|secretAccessKey|sessionToken)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+)
+
+// NewLogContext returns a context with the Nexus API log subsystem, which masks
+// HTTP auth headers and secrets within request and response bodies
+func NewLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", LogSubsystem), tflog.WithRootFields())
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, LogSubsystem, logSensitiveHeaders...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, LogSubsystem, logSensitiveJSONMembers)
+
+	return ctx
+}
@@ -0,0 +1,42 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogMasksSecrets(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"nameCode":"response-name-code","passCode":"response-pass-code"}`))
+	})
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	assert.NoError(t, c.VerifyCrowdConnection(ctx, api.CrowdSettings{
+		URL:                 "https://crowd.example.com",
+		ApplicationName:     "nexus",
+		ApplicationPassword: "request-password",
+	}))
+
+	logs := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.Equal(t, "provider."+api.LogSubsystem, entry["@module"])
+	}
+
+	assert.Contains(t, logs, "crowd.example.com")
+	assert.NotContains(t, logs, "Basic ")
+	assert.NotContains(t, logs, "request-password")
+	assert.NotContains(t, logs, "response-name-code")
+	assert.NotContains(t, logs, "response-pass-code")
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	repositoriesAPIEndpoint       = client.BasePath + "v1/repositories"
+	repositorySettingsAPIEndpoint = client.BasePath + "v1/repositorySettings"
+)
+
+// RepositorySettings contains the settings of a repository which are shared by
+// all formats
+type RepositorySettings struct {
+	Name       string                        `json:"name"`
+	Format     string                        `json:"format"`
+	Type       string                        `json:"type"`
+	Online     bool                          `json:"online"`
+	Storage    *RepositoryStorageSettings    `json:"storage,omitempty"`
+	Group      *RepositoryGroupSettings      `json:"group,omitempty"`
+	HTTPClient *RepositoryHTTPClientSettings `json:"httpClient,omitempty"`
+
+	// attributes holds all settings, including the format specific ones, which
+	// are required to update the repository
+	attributes map[string]interface{}
+}
+
+// RepositoryStorageSettings contains the storage settings of a repository
+type RepositoryStorageSettings struct {
+	BlobStoreName string `json:"blobStoreName"`
+}
+
+// RepositoryGroupSettings contains the members of a group repository
+type RepositoryGroupSettings struct {
+	MemberNames []string `json:"memberNames"`
+}
+
+// RepositoryHTTPClientSettings contains the blocking settings of a proxy
+// repository
+type RepositoryHTTPClientSettings struct {
+	Blocked   bool `json:"blocked"`
+	AutoBlock bool `json:"autoBlock"`
+}
+
+// RepositoryStatus contains the settings which control whether a repository
+// serves requests. Nil values are left unchanged.
+type RepositoryStatus struct {
+	Online    bool
+	Blocked   *bool
+	AutoBlock *bool
+}
+
+func (s *RepositorySettings) UnmarshalJSON(data []byte) error {
+	type repositorySettings RepositorySettings
+	if err := json.Unmarshal(data, (*repositorySettings)(s)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &s.attributes)
+}
+
+// repositoryEndpoint returns the format specific endpoint of a repository
+func repositoryEndpoint(format string, repositoryType string, name string) string {
+	// The maven2 format is served by the maven endpoints
+	if format == "maven2" {
+		format = "maven"
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", repositoriesAPIEndpoint, format, repositoryType, url.PathEscape(name))
+}
+
+// ListRepositorySettings returns the settings of all repositories
+func (c *Client) ListRepositorySettings(ctx context.Context) ([]RepositorySettings, error) {
+	body, resp, err := c.execute(ctx, http.MethodGet, repositorySettingsAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list repository settings: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var repositories []RepositorySettings
+	if err := json.Unmarshal(body, &repositories); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository settings: %v", err)
+	}
+	return repositories, nil
+}
+
+// GetRepositorySettings returns the settings of the repository with the given
+// name or nil if it does not exist
+func (c *Client) GetRepositorySettings(ctx context.Context, name string) (*RepositorySettings, error) {
+	repositories, err := c.ListRepositorySettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, repository := range repositories {
+		if repository.Name == name {
+			return &repository, nil
+		}
+	}
+	return nil, nil
+}
+
+// SetRepositoryStatus changes the online and blocking state of the given
+// repository without changing any other setting. Proxy repositories with
+// authentication are not supported.
+func (c *Client) SetRepositoryStatus(ctx context.Context, repository RepositorySettings, status RepositoryStatus) error {
+	attributes := repository.copyAttributes()
+	attributes["online"] = status.Online
+
+	if status.Blocked != nil || status.AutoBlock != nil {
+		httpClient, ok := attributes["httpClient"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("repository '%s' is no proxy repository and can not be blocked", repository.Name)
+		}
+		httpClientAttributes := make(map[string]interface{}, len(httpClient))
+		for key, value := range httpClient {
+			httpClientAttributes[key] = value
+		}
+		if status.Blocked != nil {
+			httpClientAttributes["blocked"] = *status.Blocked
+		}
+		if status.AutoBlock != nil {
+			httpClientAttributes["autoBlock"] = *status.AutoBlock
+		}
+		attributes["httpClient"] = httpClientAttributes
+	}
+
+	return c.updateRepository(ctx, repository, attributes)
+}
+
+// SetRepositoryGroupMembers replaces the members of the given group repository
+// without changing any other setting
+func (c *Client) SetRepositoryGroupMembers(ctx context.Context, repository RepositorySettings, memberNames []string) error {
+	attributes := repository.copyAttributes()
+
+	group, ok := attributes["group"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("repository '%s' is no group repository", repository.Name)
+	}
+	groupAttributes := make(map[string]interface{}, len(group))
+	for key, value := range group {
+		groupAttributes[key] = value
+	}
+	groupAttributes["memberNames"] = memberNames
+	attributes["group"] = groupAttributes
+
+	return c.updateRepository(ctx, repository, attributes)
+}
+
+// copyAttributes returns a shallow copy of all settings of the repository
+func (s RepositorySettings) copyAttributes() map[string]interface{} {
+	attributes := make(map[string]interface{}, len(s.attributes))
+	for key, value := range s.attributes {
+		attributes[key] = value
+	}
+	return attributes
+}
+
+// hasSigningKeypair returns whether the repository can be configured with a
+// signing keypair. Nexus does not return the keypair and its passphrase, so
+// whether one is configured is unknown.
+func (s RepositorySettings) hasSigningKeypair() bool {
+	if _, ok := s.attributes["aptSigning"]; ok {
+		return true
+	}
+	if _, ok := s.attributes["yumSigning"]; ok {
+		return true
+	}
+	return (s.Format == "apt" && s.Type == "hosted") || (s.Format == "yum" && s.Type == "group")
+}
+
+// updateRepository writes back the settings of the repository. It refuses to
+// update proxy repositories with authentication and repositories with signing
+// keypairs, as Nexus does not return the password, token or keypair, so
+// writing back the settings would remove them. The routing rule is returned as
+// routingRuleName, but has to be sent as routingRule.
+func (c *Client) updateRepository(ctx context.Context, repository RepositorySettings, attributes map[string]interface{}) error {
+	if httpClient, ok := attributes["httpClient"].(map[string]interface{}); ok && httpClient["authentication"] != nil {
+		return fmt.Errorf("repository '%s' authenticates at its remote, which can not be updated without removing the credentials", repository.Name)
+	}
+	if repository.hasSigningKeypair() {
+		return fmt.Errorf("repository '%s' of format %s can have a signing keypair, which can not be updated without removing the keypair", repository.Name, repository.Format)
+	}
+
+	if routingRuleName, ok := attributes["routingRuleName"]; ok {
+		delete(attributes, "routingRuleName")
+		attributes["routingRule"] = routingRuleName
+	}
+
+	endpoint := repositoryEndpoint(repository.Format, repository.Type, repository.Name)
+	body, resp, err := c.execute(ctx, http.MethodPut, endpoint, attributes)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", repository.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// createFormatRepository creates a repository of the given format and type
+func (c *Client) createFormatRepository(ctx context.Context, format string, repositoryType string, name string, repository interface{}) error {
+	endpoint := fmt.Sprintf("%s/%s/%s", repositoriesAPIEndpoint, format, repositoryType)
+	body, resp, err := c.execute(ctx, http.MethodPost, endpoint, repository)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// getFormatRepository reads the repository of the given format and type into
+// repository. It returns false if the repository does not exist.
+func (c *Client) getFormatRepository(ctx context.Context, format string, repositoryType string, name string, repository interface{}) (bool, error) {
+	body, resp, err := c.execute(ctx, http.MethodGet, repositoryEndpoint(format, repositoryType, name), nil)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, repository); err != nil {
+		return false, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return true, nil
+}
+
+// updateFormatRepository updates the repository of the given format and type
+func (c *Client) updateFormatRepository(ctx context.Context, format string, repositoryType string, name string, repository interface{}) error {
+	body, resp, err := c.execute(ctx, http.MethodPut, repositoryEndpoint(format, repositoryType, name), repository)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// DeleteRepository deletes the repository with the given name
+func (c *Client) DeleteRepository(ctx context.Context, name string) error {
+	body, resp, err := c.execute(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", repositoriesAPIEndpoint, url.PathEscape(name)), nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not delete repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
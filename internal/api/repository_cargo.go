@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const repositoryFormatCargo = "cargo"
+
+// CargoHostedRepository contains the configuration of a Cargo hosted
+// repository
+type CargoHostedRepository struct {
+	Name      string                   `json:"name"`
+	Online    bool                     `json:"online"`
+	Storage   repository.HostedStorage `json:"storage"`
+	Cleanup   *repository.Cleanup      `json:"cleanup,omitempty"`
+	Component *repository.Component    `json:"component,omitempty"`
+}
+
+// CargoProxyRepository contains the configuration of a Cargo proxy repository
+type CargoProxyRepository ProxyRepository
+
+// CargoGroupRepository contains the configuration of a Cargo group repository
+type CargoGroupRepository struct {
+	Name    string             `json:"name"`
+	Online  bool               `json:"online"`
+	Storage repository.Storage `json:"storage"`
+	Group   repository.Group   `json:"group"`
+}
+
+// CreateCargoHostedRepository creates a new Cargo hosted repository
+func (c *Client) CreateCargoHostedRepository(ctx context.Context, repo CargoHostedRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeHosted, repo.Name, repo)
+}
+
+// GetCargoHostedRepository returns the Cargo hosted repository with the given
+// name or nil if it does not exist
+func (c *Client) GetCargoHostedRepository(ctx context.Context, name string) (*CargoHostedRepository, error) {
+	var repo CargoHostedRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeHosted, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateCargoHostedRepository updates the Cargo hosted repository with the
+// given name
+func (c *Client) UpdateCargoHostedRepository(ctx context.Context, name string, repo CargoHostedRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeHosted, name, repo)
+}
+
+// CreateCargoProxyRepository creates a new Cargo proxy repository
+func (c *Client) CreateCargoProxyRepository(ctx context.Context, repo CargoProxyRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeProxy, repo.Name, repo)
+}
+
+// GetCargoProxyRepository returns the Cargo proxy repository with the given
+// name or nil if it does not exist
+func (c *Client) GetCargoProxyRepository(ctx context.Context, name string) (*CargoProxyRepository, error) {
+	var repo CargoProxyRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeProxy, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateCargoProxyRepository updates the Cargo proxy repository with the given
+// name
+func (c *Client) UpdateCargoProxyRepository(ctx context.Context, name string, repo CargoProxyRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeProxy, name, repo)
+}
+
+// CreateCargoGroupRepository creates a new Cargo group repository
+func (c *Client) CreateCargoGroupRepository(ctx context.Context, repo CargoGroupRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeGroup, repo.Name, repo)
+}
+
+// GetCargoGroupRepository returns the Cargo group repository with the given
+// name or nil if it does not exist
+func (c *Client) GetCargoGroupRepository(ctx context.Context, name string) (*CargoGroupRepository, error) {
+	var repo CargoGroupRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeGroup, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateCargoGroupRepository updates the Cargo group repository with the given
+// name
+func (c *Client) UpdateCargoGroupRepository(ctx context.Context, name string, repo CargoGroupRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatCargo, repository.RepositoryTypeGroup, name, repo)
+}
@@ -0,0 +1,92 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCargoProxyRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/cargo/proxy", r.URL.Path)
+
+		var repo map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+		assert.Equal(t, "cargo-proxy", repo["name"])
+		assert.Equal(t, "https://index.crates.io/", repo["proxy"].(map[string]interface{})["remoteUrl"])
+		assert.NotContains(t, repo, "cleanup")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	assert.NoError(t, c.CreateCargoProxyRepository(context.Background(), api.CargoProxyRepository{
+		Name:   "cargo-proxy",
+		Online: true,
+		Storage: repository.Storage{
+			BlobStoreName: "default",
+		},
+		Proxy: repository.Proxy{
+			RemoteURL:      "https://index.crates.io/",
+			ContentMaxAge:  1440,
+			MetadataMaxAge: 1440,
+		},
+	}))
+}
+
+func TestGetCargoGroupRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/cargo/group/cargo-public", r.URL.Path)
+		w.Write([]byte(`{
+  "name": "cargo-public",
+  "format": "cargo",
+  "type": "group",
+  "online": true,
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": true},
+  "group": {"memberNames": ["cargo-hosted", "cargo-proxy"]}
+}`))
+	})
+
+	repo, err := c.GetCargoGroupRepository(context.Background(), "cargo-public")
+	assert.NoError(t, err)
+	assert.Equal(t, "default", repo.Storage.BlobStoreName)
+	assert.Equal(t, []string{"cargo-hosted", "cargo-proxy"}, repo.Group.MemberNames)
+}
+
+func TestGetCargoHostedRepositoryNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/repositories/cargo/hosted/cargo-hosted", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	repo, err := c.GetCargoHostedRepository(context.Background(), "cargo-hosted")
+	assert.NoError(t, err)
+	assert.Nil(t, repo)
+}
+
+func TestUpdateCargoHostedRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/cargo/hosted/cargo-hosted", r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("invalid"))
+	})
+
+	err := c.UpdateCargoHostedRepository(context.Background(), "cargo-hosted", api.CargoHostedRepository{Name: "cargo-hosted"})
+	assert.EqualError(t, err, "could not update repository 'cargo-hosted': HTTP: 400, invalid")
+}
+
+func TestDeleteRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/cargo-hosted", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, c.DeleteRepository(context.Background(), "cargo-hosted"))
+}
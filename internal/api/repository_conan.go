@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	repositoryFormatConan = "conan"
+
+	ConanVersionV1 = "V1"
+	ConanVersionV2 = "V2"
+)
+
+// ConanHostedRepository contains the configuration of a Conan hosted
+// repository
+type ConanHostedRepository struct {
+	Name      string                   `json:"name"`
+	Online    bool                     `json:"online"`
+	Storage   repository.HostedStorage `json:"storage"`
+	Cleanup   *repository.Cleanup      `json:"cleanup,omitempty"`
+	Component *repository.Component    `json:"component,omitempty"`
+}
+
+// ConanProxyRepository contains the configuration of a Conan proxy repository
+type ConanProxyRepository struct {
+	Name          string                   `json:"name"`
+	Online        bool                     `json:"online"`
+	Storage       repository.Storage       `json:"storage"`
+	Proxy         repository.Proxy         `json:"proxy"`
+	NegativeCache repository.NegativeCache `json:"negativeCache"`
+	HTTPClient    repository.HTTPClient    `json:"httpClient"`
+	Cleanup       *repository.Cleanup      `json:"cleanup,omitempty"`
+	ConanProxy    *ConanProxy              `json:"conanProxy,omitempty"`
+
+	// RoutingRule is used to create and update the repository, while Nexus
+	// returns the rule as RoutingRuleName
+	RoutingRule     *string `json:"routingRule,omitempty"`
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+}
+
+// ConanProxy contains the Conan specific settings of a proxy repository
+type ConanProxy struct {
+	// ConanVersion is the Conan protocol version of the remote, V1 or V2
+	ConanVersion string `json:"conanVersion"`
+}
+
+// CreateConanHostedRepository creates a new Conan hosted repository
+func (c *Client) CreateConanHostedRepository(ctx context.Context, repo ConanHostedRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatConan, repository.RepositoryTypeHosted, repo.Name, repo)
+}
+
+// GetConanHostedRepository returns the Conan hosted repository with the given
+// name or nil if it does not exist
+func (c *Client) GetConanHostedRepository(ctx context.Context, name string) (*ConanHostedRepository, error) {
+	var repo ConanHostedRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatConan, repository.RepositoryTypeHosted, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateConanHostedRepository updates the Conan hosted repository with the
+// given name
+func (c *Client) UpdateConanHostedRepository(ctx context.Context, name string, repo ConanHostedRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatConan, repository.RepositoryTypeHosted, name, repo)
+}
+
+// CreateConanProxyRepository creates a new Conan proxy repository
+func (c *Client) CreateConanProxyRepository(ctx context.Context, repo ConanProxyRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatConan, repository.RepositoryTypeProxy, repo.Name, repo)
+}
+
+// GetConanProxyRepository returns the Conan proxy repository with the given
+// name or nil if it does not exist
+func (c *Client) GetConanProxyRepository(ctx context.Context, name string) (*ConanProxyRepository, error) {
+	var repo ConanProxyRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatConan, repository.RepositoryTypeProxy, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateConanProxyRepository updates the Conan proxy repository with the given
+// name
+func (c *Client) UpdateConanProxyRepository(ctx context.Context, name string, repo ConanProxyRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatConan, repository.RepositoryTypeProxy, name, repo)
+}
@@ -0,0 +1,64 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateConanHostedRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/conan/hosted", r.URL.Path)
+
+		var repo map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+		assert.Equal(t, map[string]interface{}{
+			"blobStoreName":               "default",
+			"strictContentTypeValidation": true,
+			"writePolicy":                 "ALLOW_ONCE",
+		}, repo["storage"])
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	writePolicy := repository.StorageWritePolicyAllowOnce
+	assert.NoError(t, c.CreateConanHostedRepository(context.Background(), api.ConanHostedRepository{
+		Name:   "conan-hosted",
+		Online: true,
+		Storage: repository.HostedStorage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+			WritePolicy:                 &writePolicy,
+		},
+	}))
+}
+
+func TestGetConanProxyRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/conan/proxy/conan-center", r.URL.Path)
+		w.Write([]byte(`{
+  "name": "conan-center",
+  "format": "conan",
+  "type": "proxy",
+  "online": true,
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": true},
+  "proxy": {"remoteUrl": "https://center2.conan.io", "contentMaxAge": 1440, "metadataMaxAge": 1440},
+  "negativeCache": {"enabled": true, "timeToLive": 1440},
+  "httpClient": {"blocked": false, "autoBlock": true},
+  "routingRuleName": "allow-all",
+  "conanProxy": {"conanVersion": "V2"}
+}`))
+	})
+
+	repo, err := c.GetConanProxyRepository(context.Background(), "conan-center")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://center2.conan.io", repo.Proxy.RemoteURL)
+	assert.Equal(t, &api.ConanProxy{ConanVersion: api.ConanVersionV2}, repo.ConanProxy)
+	assert.Equal(t, "allow-all", *repo.RoutingRuleName)
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const repositoryFormatDocker = "docker"
+
+// DockerProxyRepository contains the configuration of a Docker proxy
+// repository
+type DockerProxyRepository struct {
+	Name          string                   `json:"name"`
+	Online        bool                     `json:"online"`
+	Storage       repository.Storage       `json:"storage"`
+	Proxy         repository.Proxy         `json:"proxy"`
+	NegativeCache repository.NegativeCache `json:"negativeCache"`
+	HTTPClient    repository.HTTPClient    `json:"httpClient"`
+	Cleanup       *repository.Cleanup      `json:"cleanup,omitempty"`
+	Docker        repository.Docker        `json:"docker"`
+	DockerProxy   DockerProxy              `json:"dockerProxy"`
+
+	// RoutingRule is used to create and update the repository, while Nexus
+	// returns the rule as RoutingRuleName
+	RoutingRule     *string `json:"routingRule,omitempty"`
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+}
+
+// DockerProxy contains the Docker specific settings of a proxy repository
+type DockerProxy struct {
+	IndexType repository.DockerProxyIndexType `json:"indexType"`
+	IndexURL  *string                         `json:"indexUrl,omitempty"`
+
+	// CacheForeignLayers enables the caching of layers hosted outside of the
+	// remote registry, which are only downloaded if their URL matches one of
+	// the ForeignLayerURLWhitelist patterns
+	CacheForeignLayers       bool     `json:"cacheForeignLayers"`
+	ForeignLayerURLWhitelist []string `json:"foreignLayerUrlWhitelist,omitempty"`
+}
+
+// CreateDockerProxyRepository creates a new Docker proxy repository
+func (c *Client) CreateDockerProxyRepository(ctx context.Context, repo DockerProxyRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatDocker, repository.RepositoryTypeProxy, repo.Name, repo)
+}
+
+// GetDockerProxyRepository returns the Docker proxy repository with the given
+// name or nil if it does not exist
+func (c *Client) GetDockerProxyRepository(ctx context.Context, name string) (*DockerProxyRepository, error) {
+	var repo DockerProxyRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatDocker, repository.RepositoryTypeProxy, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateDockerProxyRepository updates the Docker proxy repository with the
+// given name
+func (c *Client) UpdateDockerProxyRepository(ctx context.Context, name string, repo DockerProxyRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatDocker, repository.RepositoryTypeProxy, name, repo)
+}
@@ -0,0 +1,70 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDockerProxyRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/docker/proxy", r.URL.Path)
+
+		var repo map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+		assert.Equal(t, map[string]interface{}{
+			"indexType":                "HUB",
+			"cacheForeignLayers":       true,
+			"foreignLayerUrlWhitelist": []interface{}{".*\\.microsoft\\.com/.*"},
+		}, repo["dockerProxy"])
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	assert.NoError(t, c.CreateDockerProxyRepository(context.Background(), api.DockerProxyRepository{
+		Name:   "docker-hub",
+		Online: true,
+		Storage: repository.Storage{
+			BlobStoreName: "default",
+		},
+		Proxy: repository.Proxy{
+			RemoteURL: "https://registry-1.docker.io",
+		},
+		DockerProxy: api.DockerProxy{
+			IndexType:                repository.DockerProxyIndexTypeHub,
+			CacheForeignLayers:       true,
+			ForeignLayerURLWhitelist: []string{".*\\.microsoft\\.com/.*"},
+		},
+	}))
+}
+
+func TestGetDockerProxyRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/docker/proxy/docker-hub", r.URL.Path)
+		w.Write([]byte(`{
+  "name": "docker-hub",
+  "format": "docker",
+  "type": "proxy",
+  "online": true,
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": true},
+  "proxy": {"remoteUrl": "https://registry-1.docker.io", "contentMaxAge": 1440, "metadataMaxAge": 1440},
+  "negativeCache": {"enabled": true, "timeToLive": 1440},
+  "httpClient": {"blocked": false, "autoBlock": true},
+  "docker": {"v1Enabled": false, "forceBasicAuth": true, "httpPort": 8082},
+  "dockerProxy": {"indexType": "HUB", "cacheForeignLayers": true, "foreignLayerUrlWhitelist": [".*"]}
+}`))
+	})
+
+	repo, err := c.GetDockerProxyRepository(context.Background(), "docker-hub")
+	assert.NoError(t, err)
+	assert.Equal(t, 8082, *repo.Docker.HTTPPort)
+	assert.Equal(t, repository.DockerProxyIndexTypeHub, repo.DockerProxy.IndexType)
+	assert.True(t, repo.DockerProxy.CacheForeignLayers)
+	assert.Equal(t, []string{".*"}, repo.DockerProxy.ForeignLayerURLWhitelist)
+}
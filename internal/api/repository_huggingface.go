@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const repositoryFormatHuggingFace = "huggingface"
+
+// HuggingFaceProxyRepository contains the configuration of a Hugging Face
+// proxy repository
+type HuggingFaceProxyRepository ProxyRepository
+
+// CreateHuggingFaceProxyRepository creates a new Hugging Face proxy repository
+func (c *Client) CreateHuggingFaceProxyRepository(ctx context.Context, repo HuggingFaceProxyRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatHuggingFace, repository.RepositoryTypeProxy, repo.Name, repo)
+}
+
+// GetHuggingFaceProxyRepository returns the Hugging Face proxy repository with
+// the given name or nil if it does not exist
+func (c *Client) GetHuggingFaceProxyRepository(ctx context.Context, name string) (*HuggingFaceProxyRepository, error) {
+	var repo HuggingFaceProxyRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatHuggingFace, repository.RepositoryTypeProxy, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateHuggingFaceProxyRepository updates the Hugging Face proxy repository
+// with the given name
+func (c *Client) UpdateHuggingFaceProxyRepository(ctx context.Context, name string, repo HuggingFaceProxyRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatHuggingFace, repository.RepositoryTypeProxy, name, repo)
+}
@@ -0,0 +1,49 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateHuggingFaceProxyRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/huggingface/proxy/huggingface", r.URL.Path)
+
+		var repo map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+		assert.Equal(t, "https://huggingface.co", repo["proxy"].(map[string]interface{})["remoteUrl"])
+		assert.Equal(t, "models", repo["routingRule"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	routingRule := "models"
+	assert.NoError(t, c.UpdateHuggingFaceProxyRepository(context.Background(), "huggingface", api.HuggingFaceProxyRepository{
+		Name:   "huggingface",
+		Online: true,
+		Storage: repository.Storage{
+			BlobStoreName: "default",
+		},
+		Proxy: repository.Proxy{
+			RemoteURL: "https://huggingface.co",
+		},
+		RoutingRule: &routingRule,
+	}))
+}
+
+func TestGetHuggingFaceProxyRepositoryNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/repositories/huggingface/proxy/huggingface", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	repo, err := c.GetHuggingFaceProxyRepository(context.Background(), "huggingface")
+	assert.NoError(t, err)
+	assert.Nil(t, repo)
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+// ProxyRepository contains the configuration of a proxy repository of a
+// format without format specific attributes
+type ProxyRepository struct {
+	Name          string                   `json:"name"`
+	Online        bool                     `json:"online"`
+	Storage       repository.Storage       `json:"storage"`
+	Proxy         repository.Proxy         `json:"proxy"`
+	NegativeCache repository.NegativeCache `json:"negativeCache"`
+	HTTPClient    repository.HTTPClient    `json:"httpClient"`
+	Cleanup       *repository.Cleanup      `json:"cleanup,omitempty"`
+
+	// RoutingRule is used to create and update the repository, while Nexus
+	// returns the rule as RoutingRuleName
+	RoutingRule     *string `json:"routingRule,omitempty"`
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const repositoryFormatPypi = "pypi"
+
+// PypiGroupRepository contains the configuration of a PyPI group
+// repository, including the writable member for group deployment
+type PypiGroupRepository struct {
+	Name    string                 `json:"name"`
+	Online  bool                   `json:"online"`
+	Storage repository.Storage     `json:"storage"`
+	Group   repository.GroupDeploy `json:"group"`
+}
+
+// CreatePypiGroupRepository creates a new PyPI group repository
+func (c *Client) CreatePypiGroupRepository(ctx context.Context, repo PypiGroupRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatPypi, repository.RepositoryTypeGroup, repo.Name, repo)
+}
+
+// GetPypiGroupRepository returns the PyPI group repository with the given
+// name or nil if it does not exist
+func (c *Client) GetPypiGroupRepository(ctx context.Context, name string) (*PypiGroupRepository, error) {
+	var repo PypiGroupRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatPypi, repository.RepositoryTypeGroup, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdatePypiGroupRepository updates the PyPI group repository with the
+// given name
+func (c *Client) UpdatePypiGroupRepository(ctx context.Context, name string, repo PypiGroupRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatPypi, repository.RepositoryTypeGroup, name, repo)
+}
@@ -0,0 +1,40 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePypiGroupRepository(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/pypi/group/pypi-public", r.URL.Path)
+
+		var repo map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+		assert.Equal(t, map[string]interface{}{
+			"memberNames":    []interface{}{"pypi-internal", "pypi-org"},
+			"writableMember": "pypi-internal",
+		}, repo["group"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	writableMember := "pypi-internal"
+	assert.NoError(t, c.UpdatePypiGroupRepository(context.Background(), "pypi-public", api.PypiGroupRepository{
+		Name:   "pypi-public",
+		Online: true,
+		Storage: repository.Storage{
+			BlobStoreName: "default",
+		},
+		Group: repository.GroupDeploy{
+			MemberNames:    []string{"pypi-internal", "pypi-org"},
+			WritableMember: &writableMember,
+		},
+	}))
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const repositoryFormatR = "r"
+
+// RGroupRepository contains the configuration of an R group
+// repository, including the writable member for group deployment
+type RGroupRepository struct {
+	Name    string                 `json:"name"`
+	Online  bool                   `json:"online"`
+	Storage repository.Storage     `json:"storage"`
+	Group   repository.GroupDeploy `json:"group"`
+}
+
+// CreateRGroupRepository creates a new R group repository
+func (c *Client) CreateRGroupRepository(ctx context.Context, repo RGroupRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatR, repository.RepositoryTypeGroup, repo.Name, repo)
+}
+
+// GetRGroupRepository returns the R group repository with the given
+// name or nil if it does not exist
+func (c *Client) GetRGroupRepository(ctx context.Context, name string) (*RGroupRepository, error) {
+	var repo RGroupRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatR, repository.RepositoryTypeGroup, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateRGroupRepository updates the R group repository with the
+// given name
+func (c *Client) UpdateRGroupRepository(ctx context.Context, name string, repo RGroupRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatR, repository.RepositoryTypeGroup, name, repo)
+}
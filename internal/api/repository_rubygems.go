@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const repositoryFormatRubyGems = "rubygems"
+
+// RubyGemsGroupRepository contains the configuration of a RubyGems group
+// repository, including the writable member for group deployment
+type RubyGemsGroupRepository struct {
+	Name    string                 `json:"name"`
+	Online  bool                   `json:"online"`
+	Storage repository.Storage     `json:"storage"`
+	Group   repository.GroupDeploy `json:"group"`
+}
+
+// CreateRubyGemsGroupRepository creates a new RubyGems group repository
+func (c *Client) CreateRubyGemsGroupRepository(ctx context.Context, repo RubyGemsGroupRepository) error {
+	return c.createFormatRepository(ctx, repositoryFormatRubyGems, repository.RepositoryTypeGroup, repo.Name, repo)
+}
+
+// GetRubyGemsGroupRepository returns the RubyGems group repository with the given
+// name or nil if it does not exist
+func (c *Client) GetRubyGemsGroupRepository(ctx context.Context, name string) (*RubyGemsGroupRepository, error) {
+	var repo RubyGemsGroupRepository
+	if ok, err := c.getFormatRepository(ctx, repositoryFormatRubyGems, repository.RepositoryTypeGroup, name, &repo); !ok {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// UpdateRubyGemsGroupRepository updates the RubyGems group repository with the
+// given name
+func (c *Client) UpdateRubyGemsGroupRepository(ctx context.Context, name string, repo RubyGemsGroupRepository) error {
+	return c.updateFormatRepository(ctx, repositoryFormatRubyGems, repository.RepositoryTypeGroup, name, repo)
+}
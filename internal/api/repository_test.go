@@ -0,0 +1,210 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRepositorySettings = `[
+  {
+    "name": "maven-releases",
+    "format": "maven2",
+    "type": "hosted",
+    "online": true,
+    "storage": {"blobStoreName": "default", "strictContentTypeValidation": true, "writePolicy": "ALLOW_ONCE"},
+    "maven": {"versionPolicy": "RELEASE", "layoutPolicy": "STRICT"}
+  },
+  {
+    "name": "maven-public",
+    "format": "maven2",
+    "type": "group",
+    "online": true,
+    "storage": {"blobStoreName": "default", "strictContentTypeValidation": true},
+    "group": {"memberNames": ["maven-releases"]}
+  }
+]`
+
+func TestListRepositorySettings(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositorySettings", r.URL.Path)
+		w.Write([]byte(testRepositorySettings))
+	})
+
+	repositories, err := c.ListRepositorySettings(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, repositories, 2)
+	assert.Equal(t, "maven-releases", repositories[0].Name)
+	assert.Equal(t, "default", repositories[0].Storage.BlobStoreName)
+	assert.Nil(t, repositories[0].Group)
+	assert.Equal(t, []string{"maven-releases"}, repositories[1].Group.MemberNames)
+}
+
+func TestSetRepositoryStatus(t *testing.T) {
+	const proxy = `[{
+  "name": "maven-central",
+  "format": "maven2",
+  "type": "proxy",
+  "online": true,
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": false},
+  "httpClient": {"blocked": false, "autoBlock": true, "connection": {"retries": 2}}
+}]`
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(proxy))
+			return
+		}
+
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/maven/proxy/maven-central", r.URL.Path)
+
+		var repository map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repository))
+		assert.Equal(t, false, repository["online"])
+		assert.Equal(t, map[string]interface{}{"blocked": true, "autoBlock": true, "connection": map[string]interface{}{"retries": float64(2)}}, repository["httpClient"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	repository, err := c.GetRepositorySettings(context.Background(), "maven-central")
+	assert.NoError(t, err)
+	assert.True(t, repository.HTTPClient.AutoBlock)
+
+	blocked := true
+	assert.NoError(t, c.SetRepositoryStatus(context.Background(), *repository, api.RepositoryStatus{Online: false, Blocked: &blocked}))
+
+	missing, err := c.GetRepositorySettings(context.Background(), "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestSetRepositoryStatusNoProxy(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testRepositorySettings))
+	})
+
+	repository, err := c.GetRepositorySettings(context.Background(), "maven-releases")
+	assert.NoError(t, err)
+
+	blocked := true
+	assert.ErrorContains(t, c.SetRepositoryStatus(context.Background(), *repository, api.RepositoryStatus{Online: true, Blocked: &blocked}), "is no proxy repository")
+}
+
+func TestSetRepositoryStatusAuthentication(t *testing.T) {
+	const proxy = `[{
+  "name": "maven-central",
+  "format": "maven2",
+  "type": "proxy",
+  "online": true,
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": false},
+  "httpClient": {"blocked": false, "autoBlock": true, "authentication": {"type": "username", "username": "user"}}
+}]`
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Write([]byte(proxy))
+	})
+
+	repository, err := c.GetRepositorySettings(context.Background(), "maven-central")
+	assert.NoError(t, err)
+
+	assert.ErrorContains(t, c.SetRepositoryStatus(context.Background(), *repository, api.RepositoryStatus{Online: false}), "without removing the credentials")
+}
+
+func TestSetRepositoryStatusRoutingRule(t *testing.T) {
+	const proxy = `[{
+  "name": "npm-proxy",
+  "format": "npm",
+  "type": "proxy",
+  "online": true,
+  "routingRuleName": "block-company-scopes",
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": true},
+  "httpClient": {"blocked": false, "autoBlock": true}
+}]`
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(proxy))
+			return
+		}
+
+		var repository map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repository))
+		assert.Equal(t, "block-company-scopes", repository["routingRule"])
+		assert.NotContains(t, repository, "routingRuleName")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	repository, err := c.GetRepositorySettings(context.Background(), "npm-proxy")
+	assert.NoError(t, err)
+	assert.NoError(t, c.SetRepositoryStatus(context.Background(), *repository, api.RepositoryStatus{Online: false}))
+}
+
+func TestSetRepositoryStatusSigning(t *testing.T) {
+	const hosted = `[{
+  "name": "apt-hosted",
+  "format": "apt",
+  "type": "hosted",
+  "online": true,
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": true, "writePolicy": "ALLOW"},
+  "apt": {"distribution": "bionic"}
+}]`
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Write([]byte(hosted))
+	})
+
+	repository, err := c.GetRepositorySettings(context.Background(), "apt-hosted")
+	assert.NoError(t, err)
+
+	assert.ErrorContains(t, c.SetRepositoryStatus(context.Background(), *repository, api.RepositoryStatus{Online: false}), "without removing the keypair")
+}
+
+func TestSetRepositoryGroupMembersSigning(t *testing.T) {
+	const group = `[{
+  "name": "yum-group",
+  "format": "yum",
+  "type": "group",
+  "online": true,
+  "storage": {"blobStoreName": "default", "strictContentTypeValidation": true},
+  "group": {"memberNames": ["yum-hosted"]}
+}]`
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Write([]byte(group))
+	})
+
+	repository, err := c.GetRepositorySettings(context.Background(), "yum-group")
+	assert.NoError(t, err)
+
+	assert.ErrorContains(t, c.SetRepositoryGroupMembers(context.Background(), *repository, []string{"yum-hosted", "yum-proxy"}), "without removing the keypair")
+}
+
+func TestSetRepositoryGroupMembers(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(testRepositorySettings))
+			return
+		}
+
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/service/rest/v1/repositories/maven/group/maven-public", r.URL.Path)
+
+		var repository map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&repository))
+		assert.Equal(t, map[string]interface{}{"memberNames": []interface{}{"maven-snapshots", "maven-releases"}}, repository["group"])
+		assert.Equal(t, map[string]interface{}{"blobStoreName": "default", "strictContentTypeValidation": true}, repository["storage"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	repository, err := c.GetRepositorySettings(context.Background(), "maven-public")
+	assert.NoError(t, err)
+	assert.NoError(t, c.SetRepositoryGroupMembers(context.Background(), *repository, []string{"maven-snapshots", "maven-releases"}))
+	assert.Equal(t, []string{"maven-releases"}, repository.Group.MemberNames)
+
+	hosted, err := c.GetRepositorySettings(context.Background(), "maven-releases")
+	assert.NoError(t, err)
+	assert.ErrorContains(t, c.SetRepositoryGroupMembers(context.Background(), *hosted, []string{"maven-snapshots"}), "is no group repository")
+}
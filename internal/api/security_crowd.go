@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	securityCrowdAPIEndpoint = securityAPIEndpoint + "/atlassian-crowd"
+)
+
+// CrowdSettings contains the Atlassian Crowd configuration
+type CrowdSettings struct {
+	Enabled             bool   `json:"enabled"`
+	RealmActive         bool   `json:"realmActive"`
+	URL                 string `json:"url"`
+	ApplicationName     string `json:"applicationName"`
+	ApplicationPassword string `json:"applicationPassword,omitempty"`
+	UseTrustStoreForURL bool   `json:"useTrustStoreForUrl"`
+	Timeout             int    `json:"timeout,omitempty"`
+}
+
+// GetCrowd returns the Atlassian Crowd configuration
+func (c *Client) GetCrowd(ctx context.Context) (*CrowdSettings, error) {
+	body, resp, err := c.execute(ctx, http.MethodGet, securityCrowdAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get Crowd configuration: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var settings CrowdSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("could not unmarshal Crowd configuration: %v", err)
+	}
+	return &settings, nil
+}
+
+// UpdateCrowd replaces the Atlassian Crowd configuration
+func (c *Client) UpdateCrowd(ctx context.Context, settings CrowdSettings) error {
+	body, resp, err := c.execute(ctx, http.MethodPut, securityCrowdAPIEndpoint, settings)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update Crowd configuration: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// VerifyCrowdConnection lets Nexus test the connection to Crowd with the
+// given configuration without saving it
+func (c *Client) VerifyCrowdConnection(ctx context.Context, settings CrowdSettings) error {
+	body, resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/verify-connection", securityCrowdAPIEndpoint), settings)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not verify connection to Crowd at %s: HTTP: %d, %s", settings.URL, resp.StatusCode, string(body))
+	}
+	return nil
+}
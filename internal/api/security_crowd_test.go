@@ -0,0 +1,36 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyCrowdConnection(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest/v1/security/atlassian-crowd/verify-connection", r.URL.Path)
+
+		var settings api.CrowdSettings
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&settings))
+		if settings.ApplicationPassword != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	settings := api.CrowdSettings{
+		URL:                 "https://crowd.example.com",
+		ApplicationName:     "nexus",
+		ApplicationPassword: "secret",
+	}
+	assert.NoError(t, c.VerifyCrowdConnection(context.Background(), settings))
+
+	settings.ApplicationPassword = "wrong"
+	assert.Error(t, c.VerifyCrowdConnection(context.Background(), settings))
+}
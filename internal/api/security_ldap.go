@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+const (
+	securityLDAPAPIEndpoint = securityAPIEndpoint + "/ldap"
+)
+
+// LDAPUser is a user as found by Nexus with a LDAP configuration
+type LDAPUser struct {
+	Username   string   `json:"username"`
+	RealName   string   `json:"realName"`
+	Email      string   `json:"email"`
+	Membership []string `json:"membership"`
+}
+
+// LDAPLogin is a LDAP configuration together with the credentials of a test user
+type LDAPLogin struct {
+	security.LDAP
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// VerifyLDAPConnection lets Nexus test the connection to the LDAP server
+// of the given configuration without saving it
+func (c *Client) VerifyLDAPConnection(ctx context.Context, ldap security.LDAP) error {
+	body, resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/verify-connection", securityLDAPAPIEndpoint), ldap)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not connect to LDAP server %s:%d: HTTP: %d, %s", ldap.Host, ldap.Port, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// VerifyLDAPUserMapping returns the users which Nexus finds with the user
+// and group mapping of the given configuration
+func (c *Client) VerifyLDAPUserMapping(ctx context.Context, ldap security.LDAP) ([]LDAPUser, error) {
+	body, resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/verify-user-mapping", securityLDAPAPIEndpoint), ldap)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not verify LDAP user mapping: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var users []LDAPUser
+	if err := json.Unmarshal(body, &users); err != nil {
+		return nil, fmt.Errorf("could not unmarshal LDAP users: %v", err)
+	}
+	return users, nil
+}
+
+// VerifyLDAPLogin lets Nexus authenticate the given test user with the
+// LDAP configuration
+func (c *Client) VerifyLDAPLogin(ctx context.Context, login LDAPLogin) error {
+	body, resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/verify-login", securityLDAPAPIEndpoint), login)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not login as LDAP user '%s': HTTP: %d, %s", login.Username, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyLDAPUserMapping(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/security/ldap/verify-user-mapping", r.URL.Path)
+		w.Write([]byte(`[{"username":"jdoe","realName":"John Doe","email":"jdoe@example.com","membership":["developers"]}]`))
+	})
+
+	users, err := c.VerifyLDAPUserMapping(context.Background(), security.LDAP{Name: "example"})
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "jdoe", users[0].Username)
+	assert.Equal(t, []string{"developers"}, users[0].Membership)
+}
+
+func TestVerifyLDAPLogin(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/security/ldap/verify-login", r.URL.Path)
+
+		var login map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&login))
+		assert.Equal(t, "example", login["name"])
+		if login["password"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	login := api.LDAPLogin{
+		LDAP:     security.LDAP{Name: "example"},
+		Username: "jdoe",
+		Password: "secret",
+	}
+	assert.NoError(t, c.VerifyLDAPLogin(context.Background(), login))
+
+	login.Password = "wrong"
+	assert.Error(t, c.VerifyLDAPLogin(context.Background(), login))
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	securityUsersAPIEndpoint      = securityAPIEndpoint + "/users"
+	securityUserTokensAPIEndpoint = securityAPIEndpoint + "/user-tokens"
+)
+
+// UserExists returns whether a user with the given id exists in any of the
+// user sources
+func (c *Client) UserExists(ctx context.Context, userID string) (bool, error) {
+	endpoint := fmt.Sprintf("%s?userId=%s", securityUsersAPIEndpoint, url.QueryEscape(userID))
+	body, resp, err := c.execute(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("could not get user '%s': HTTP: %d, %s", userID, resp.StatusCode, string(body))
+	}
+
+	var users []struct {
+		UserID string `json:"userId"`
+	}
+	if err := json.Unmarshal(body, &users); err != nil {
+		return false, fmt.Errorf("could not unmarshal users: %v", err)
+	}
+	// Nexus returns all users whose id starts with the given id
+	for _, user := range users {
+		if user.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ResetUserToken invalidates the user token of the given user. Resetting the
+// token of a user without a token succeeds, resetting the token of an
+// unknown user fails.
+func (c *Client) ResetUserToken(ctx context.Context, userID string) error {
+	endpoint := fmt.Sprintf("%s/%s", securityUserTokensAPIEndpoint, url.PathEscape(userID))
+	body, resp, err := c.execute(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		// Nexus responds the same way for unknown users and users without a
+		// token
+		exists, err := c.UserExists(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("could not reset user token of user '%s': the user does not exist", userID)
+		}
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not reset user token of user '%s': HTTP: %d, %s", userID, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResetUserToken(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/service/rest/v1/security/user-tokens/ci", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, c.ResetUserToken(context.Background(), "ci"))
+}
+
+func TestResetUserTokenError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	assert.Error(t, c.ResetUserToken(context.Background(), "ci"))
+}
+
+func TestResetUserTokenUnknownUser(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			assert.Equal(t, "/service/rest/v1/security/users", r.URL.Path)
+			assert.Equal(t, "unknown", r.URL.Query().Get("userId"))
+			w.Write([]byte(`[{"userId": "unknown-other"}]`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	assert.ErrorContains(t, c.ResetUserToken(context.Background(), "unknown"), "the user does not exist")
+}
+
+func TestResetUserTokenWithoutToken(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[{"userId": "ci"}]`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	assert.NoError(t, c.ResetUserToken(context.Background(), "ci"))
+}
+
+func TestUserExists(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Write([]byte(`[{"userId": "ci"}, {"userId": "ci-deploy"}]`))
+	})
+
+	exists, err := c.UserExists(context.Background(), "ci")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = c.UserExists(context.Background(), "c")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
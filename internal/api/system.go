@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	systemInformationAPIEndpoint = client.BasePath + "atlas/system-information"
+)
+
+// SystemInformation contains the parts of the Nexus system information the
+// provider relies on
+type SystemInformation struct {
+	Status        SystemStatus        `json:"nexus-status"`
+	Configuration SystemConfiguration `json:"nexus-configuration"`
+}
+
+// SystemStatus contains the version and edition of Nexus
+type SystemStatus struct {
+	Version string `json:"version"`
+	Edition string `json:"edition"`
+}
+
+// IsPro returns whether Nexus runs with a Pro license
+func (s SystemStatus) IsPro() bool {
+	return strings.EqualFold(s.Edition, "PRO")
+}
+
+// SystemConfiguration contains the directories of the Nexus installation
+type SystemConfiguration struct {
+	InstallDirectory string `json:"installDirectory"`
+	WorkingDirectory string `json:"workingDirectory"`
+}
+
+// GetSystemInformation returns the system information of Nexus
+func (c *Client) GetSystemInformation(ctx context.Context) (*SystemInformation, error) {
+	body, resp, err := c.execute(ctx, http.MethodGet, systemInformationAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get system information: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var info SystemInformation
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("could not unmarshal system information: %v", err)
+	}
+	return &info, nil
+}
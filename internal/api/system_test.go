@@ -0,0 +1,30 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSystemInformation(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/service/rest/atlas/system-information", r.URL.Path)
+		w.Write([]byte(`{
+  "nexus-status": {"version": "3.41.1-01", "edition": "OSS"},
+  "nexus-configuration": {
+    "installDirectory": "/opt/sonatype/nexus",
+    "workingDirectory": "/nexus-data",
+    "temporaryDirectory": "/opt/sonatype/nexus/../sonatype-work/nexus3/tmp"
+  }
+}`))
+	})
+
+	info, err := c.GetSystemInformation(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "/nexus-data", info.Configuration.WorkingDirectory)
+	assert.Equal(t, "3.41.1-01", info.Status.Version)
+	assert.False(t, info.Status.IsPro())
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	tasksAPIEndpoint = client.BasePath + "v1/tasks"
+
+	TaskStateWaiting   = "WAITING"
+	TaskResultOK       = "OK"
+	TaskScheduleManual = "manual"
+)
+
+// temporaryTaskDeleteTimeout is the time a temporary task may take to be
+// deleted after its run has finished or the wait for it has been given up
+var temporaryTaskDeleteTimeout = 30 * time.Second
+
+// TaskTemplate contains the settings of a new task
+type TaskTemplate struct {
+	Type                  string            `json:"type"`
+	Name                  string            `json:"name"`
+	Enabled               bool              `json:"enabled"`
+	AlertEmail            string            `json:"alertEmail,omitempty"`
+	NotificationCondition string            `json:"notificationCondition"`
+	Frequency             TaskFrequency     `json:"frequency"`
+	Properties            map[string]string `json:"properties"`
+}
+
+// TaskFrequency contains the schedule of a task
+type TaskFrequency struct {
+	Schedule string `json:"schedule"`
+}
+
+// Task contains the state of a task
+type Task struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Message       string `json:"message"`
+	CurrentState  string `json:"currentState"`
+	LastRunResult string `json:"lastRunResult"`
+}
+
+func taskEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", tasksAPIEndpoint, url.PathEscape(id))
+}
+
+// CreateTask creates a new task and returns its ID
+func (c *Client) CreateTask(ctx context.Context, task TaskTemplate) (string, error) {
+	body, resp, err := c.execute(ctx, http.MethodPost, tasksAPIEndpoint, task)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not create task '%s': HTTP: %d, %s", task.Name, resp.StatusCode, string(body))
+	}
+
+	var created Task
+	if err := json.Unmarshal(body, &created); err != nil {
+		return "", fmt.Errorf("could not unmarshal task: %v", err)
+	}
+	return created.ID, nil
+}
+
+// GetTask returns the task with the given ID or nil if it does not exist
+func (c *Client) GetTask(ctx context.Context, id string) (*Task, error) {
+	body, resp, err := c.execute(ctx, http.MethodGet, taskEndpoint(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get task '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+
+	var task Task
+	if err := json.Unmarshal(body, &task); err != nil {
+		return nil, fmt.Errorf("could not unmarshal task: %v", err)
+	}
+	return &task, nil
+}
+
+// RunTask starts the task with the given ID
+func (c *Client) RunTask(ctx context.Context, id string) error {
+	body, resp, err := c.execute(ctx, http.MethodPost, taskEndpoint(id)+"/run", nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not run task '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// DeleteTask deletes the task with the given ID
+func (c *Client) DeleteTask(ctx context.Context, id string) error {
+	body, resp, err := c.execute(ctx, http.MethodDelete, taskEndpoint(id), nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("could not delete task '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// WaitForTask polls the task until its run has finished and returns an error
+// if the run did not succeed or ctx is done first
+func (c *Client) WaitForTask(ctx context.Context, id string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		task, err := c.GetTask(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("task '%s' did not finish in time: %v", id, ctx.Err())
+			}
+			return err
+		}
+		if task == nil {
+			return fmt.Errorf("task '%s' does not exist anymore", id)
+		}
+		if task.CurrentState == TaskStateWaiting && task.LastRunResult != "" {
+			if task.LastRunResult != TaskResultOK {
+				return fmt.Errorf("task '%s' finished with result %s: %s", task.Name, task.LastRunResult, task.Message)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("task '%s' did not finish in time: %v", task.Name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// detachedContext keeps the values of its parent, e.g. the logger, but not its
+// deadline and cancellation
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// RunTemporaryTask creates the task, runs it once and waits until the run has
+// finished. The task is deleted afterwards in any case, also if ctx is done
+// because the wait timed out.
+func (c *Client) RunTemporaryTask(ctx context.Context, task TaskTemplate, interval time.Duration) error {
+	id, err := c.CreateTask(ctx, task)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		deleteCtx, cancel := context.WithTimeout(detachedContext{parent: ctx}, temporaryTaskDeleteTimeout)
+		defer cancel()
+		if err := c.DeleteTask(deleteCtx, id); err != nil {
+			tflog.Warn(ctx, "Could not delete the temporary task", map[string]interface{}{
+				"task_id": id,
+				"error":   err.Error(),
+			})
+		}
+	}()
+
+	tflog.Info(ctx, "Running temporary task", map[string]interface{}{
+		"task_id":   id,
+		"task_name": task.Name,
+	})
+	if err := c.RunTask(ctx, id); err != nil {
+		return err
+	}
+
+	return c.WaitForTask(ctx, id, interval)
+}
@@ -0,0 +1,126 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTask(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest/v1/tasks", r.URL.Path)
+
+		var task map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&task))
+		assert.Equal(t, "repository.move", task["type"])
+		assert.Equal(t, map[string]interface{}{"schedule": "manual"}, task["frequency"])
+		assert.Equal(t, map[string]interface{}{"repositoryName": "maven-releases"}, task["properties"])
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "0a6b2c7d"}`))
+	})
+
+	id, err := c.CreateTask(context.Background(), api.TaskTemplate{
+		Type:                  "repository.move",
+		Name:                  "move",
+		Enabled:               true,
+		NotificationCondition: "FAILURE",
+		Frequency:             api.TaskFrequency{Schedule: api.TaskScheduleManual},
+		Properties:            map[string]string{"repositoryName": "maven-releases"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "0a6b2c7d", id)
+}
+
+func TestWaitForTask(t *testing.T) {
+	runs := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/tasks/0a6b2c7d", r.URL.Path)
+
+		runs++
+		if runs < 3 {
+			w.Write([]byte(`{"id": "0a6b2c7d", "name": "move", "currentState": "RUNNING"}`))
+			return
+		}
+		w.Write([]byte(`{"id": "0a6b2c7d", "name": "move", "currentState": "WAITING", "lastRunResult": "FAILED", "message": "blob store missing"}`))
+	})
+
+	err := c.WaitForTask(context.Background(), "0a6b2c7d", time.Millisecond)
+	assert.ErrorContains(t, err, "finished with result FAILED: blob store missing")
+	assert.Equal(t, 3, runs)
+}
+
+func TestWaitForTaskTimeout(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "0a6b2c7d", "name": "move", "currentState": "RUNNING"}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorContains(t, c.WaitForTask(ctx, "0a6b2c7d", time.Millisecond), "did not finish in time")
+}
+
+func TestRunTemporaryTask(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/tasks":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "0a6b2c7d"}`))
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"id": "0a6b2c7d", "name": "move", "currentState": "WAITING", "lastRunResult": "OK"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	assert.NoError(t, c.RunTemporaryTask(context.Background(), api.TaskTemplate{
+		Type:      "repository.move",
+		Name:      "move",
+		Frequency: api.TaskFrequency{Schedule: api.TaskScheduleManual},
+	}, time.Millisecond))
+	assert.Equal(t, []string{
+		"POST /service/rest/v1/tasks",
+		"POST /service/rest/v1/tasks/0a6b2c7d/run",
+		"GET /service/rest/v1/tasks/0a6b2c7d",
+		"DELETE /service/rest/v1/tasks/0a6b2c7d",
+	}, requests)
+}
+
+func TestRunTemporaryTaskTimeout(t *testing.T) {
+	var deleted bool
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/tasks":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "0a6b2c7d"}`))
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"id": "0a6b2c7d", "name": "move", "currentState": "RUNNING"}`))
+		case r.Method == http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := c.RunTemporaryTask(ctx, api.TaskTemplate{
+		Type:      "repository.move",
+		Name:      "move",
+		Frequency: api.TaskFrequency{Schedule: api.TaskScheduleManual},
+	}, time.Millisecond)
+	assert.ErrorContains(t, err, "did not finish in time")
+	assert.True(t, deleted, "the task has not been deleted after the timeout")
+}
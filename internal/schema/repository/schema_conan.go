@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	ResourceConanProxy = &schema.Schema{
+		Computed:    true,
+		Description: "Conan specific settings of the proxy repository. Requires a Nexus version with Conan v2 support",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"conan_version": {
+					Description: "The Conan protocol version of the remote repository. Possible values: `V1` or `V2`",
+					Required:    true,
+					Type:        schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						api.ConanVersionV1,
+						api.ConanVersionV2,
+					}, false),
+				},
+			},
+		},
+		MaxItems: 1,
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+	DataSourceConanProxy = &schema.Schema{
+		Computed:    true,
+		Description: "Conan specific settings of the proxy repository",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"conan_version": {
+					Description: "The Conan protocol version of the remote repository",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+			},
+		},
+		Type: schema.TypeList,
+	}
+)
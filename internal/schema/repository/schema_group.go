@@ -7,22 +7,41 @@ import (
 )
 
 var (
+	ResourceGroupMemberNames = &schema.Schema{
+		Computed:    true,
+		Description: "Member repositories names. Use `members` instead if the order of the members matters",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		ExactlyOneOf: []string{"group.0.member_names", "group.0.members"},
+		MinItems:     1,
+		Optional:     true,
+		Set: func(v interface{}) int {
+			return schema.HashString(strings.ToLower(v.(string)))
+		},
+		Type: schema.TypeSet,
+	}
+	ResourceGroupMembers = &schema.Schema{
+		Computed: true,
+		Description: "Member repositories names in the order Nexus searches them. " +
+			"Replaces `member_names`, switching to `members` with the current order of the group does not change the repository",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		ExactlyOneOf: []string{"group.0.member_names", "group.0.members"},
+		MinItems:     1,
+		Optional:     true,
+		Type:         schema.TypeList,
+	}
 	ResourceGroup = &schema.Schema{
 		Description: "Configuration for repository group",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"member_names": {
-					Description: "Member repositories names",
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
-					},
-					MinItems: 1,
-					Required: true,
-					Set: func(v interface{}) int {
-						return schema.HashString(strings.ToLower(v.(string)))
-					},
-					Type: schema.TypeSet,
-				},
+				"member_names": ResourceGroupMemberNames,
+				"members":      ResourceGroupMembers,
 			},
 		},
 		MaxItems: 1,
@@ -33,20 +52,10 @@ var (
 		Description: "Configuration for repository group",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"member_names": {
-					Description: "Member repositories names",
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
-					},
-					MinItems: 1,
-					Required: true,
-					Set: func(v interface{}) int {
-						return schema.HashString(strings.ToLower(v.(string)))
-					},
-					Type: schema.TypeSet,
-				},
+				"member_names": ResourceGroupMemberNames,
+				"members":      ResourceGroupMembers,
 				"writable_member": {
-					Description: "Pro-only: This field is for the Group Deployment feature available in NXRM Pro.",
+					Description: "Pro-only: This field is for the Group Deployment feature available in NXRM Pro. It must be one of the members and a hosted repository of the same format",
 					Optional:    true,
 					Type:        schema.TypeString,
 				},
@@ -68,6 +77,14 @@ var (
 					Computed: true,
 					Type:     schema.TypeSet,
 				},
+				"members": {
+					Description: "Member repositories names in the order Nexus searches them",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Computed: true,
+					Type:     schema.TypeList,
+				},
 			},
 		},
 		Computed: true,
@@ -85,6 +102,14 @@ var (
 					Computed: true,
 					Type:     schema.TypeSet,
 				},
+				"members": {
+					Description: "Member repositories names in the order Nexus searches them",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Computed: true,
+					Type:     schema.TypeList,
+				},
 				"writable_member": {
 					Description: "Pro-only: This field is for the Group Deployment feature available in NXRM Pro.",
 					Computed:    true,
@@ -15,14 +15,16 @@ var (
 			Schema: map[string]*schema.Schema{
 				"authentication": ResourceHTTPClientAuthentication,
 				"auto_block": {
-					Description: "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
-					Required:    true,
-					Type:        schema.TypeBool,
+					Description:      "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
+					DiffSuppressFunc: suppressIgnoredStatusChange,
+					Required:         true,
+					Type:             schema.TypeBool,
 				},
 				"blocked": {
-					Description: "Whether to block outbound connections on the repository",
-					Required:    true,
-					Type:        schema.TypeBool,
+					Description:      "Whether to block outbound connections on the repository",
+					DiffSuppressFunc: suppressIgnoredStatusChange,
+					Required:         true,
+					Type:             schema.TypeBool,
 				},
 				"connection": ResourceHTTPClientConnection,
 			},
@@ -38,14 +40,16 @@ var (
 			Schema: map[string]*schema.Schema{
 				"authentication": ResourceHTTPClientAuthenticationWithPreemptive,
 				"auto_block": {
-					Description: "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
-					Required:    true,
-					Type:        schema.TypeBool,
+					Description:      "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
+					DiffSuppressFunc: suppressIgnoredStatusChange,
+					Required:         true,
+					Type:             schema.TypeBool,
 				},
 				"blocked": {
-					Description: "Whether to block outbound connections on the repository",
-					Required:    true,
-					Type:        schema.TypeBool,
+					Description:      "Whether to block outbound connections on the repository",
+					DiffSuppressFunc: suppressIgnoredStatusChange,
+					Required:         true,
+					Type:             schema.TypeBool,
 				},
 				"connection": ResourceHTTPClientConnection,
 			},
@@ -6,10 +6,17 @@ import (
 
 var (
 	ResourceOnline = &schema.Schema{
-		Default:     true,
-		Description: "Whether this repository accepts incoming requests",
-		Optional:    true,
-		Type:        schema.TypeBool,
+		Default:          true,
+		Description:      "Whether this repository accepts incoming requests",
+		DiffSuppressFunc: suppressIgnoredStatusChange,
+		Optional:         true,
+		Type:             schema.TypeBool,
+	}
+	ResourceIgnoreStatusChanges = &schema.Schema{
+		Description: "Ignore changes of `online` and, for proxy repositories, of `blocked` and `auto_block` of the `http_client`, " +
+			"so an external controller like `nexus_repository_status` can own them. The configured values are only used to create the repository",
+		Optional: true,
+		Type:     schema.TypeBool,
 	}
 	DataSourceOnline = &schema.Schema{
 		Description: "Whether this repository accepts incoming requests",
@@ -17,3 +24,10 @@ var (
 		Computed:    true,
 	}
 )
+
+// suppressIgnoredStatusChange suppresses changes of the online and blocking
+// state of existing repositories with ignore_status_changes
+func suppressIgnoredStatusChange(k, old, new string, d *schema.ResourceData) bool {
+	ignore, ok := d.Get("ignore_status_changes").(bool)
+	return ok && ignore && d.Id() != ""
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceSigningKeyFingerprint = &schema.Schema{
+		Description: "Fingerprint of the configured PGP signing key pair. It is computed from the configuration only, " +
+			"Nexus never returns the key pair, so a key pair changed outside of Terraform is not detected",
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	ResourceSigningKeyID = &schema.Schema{
+		Description: "Long key ID of the configured PGP signing key pair. It is computed from the configuration only, " +
+			"Nexus never returns the key pair, so a key pair changed outside of Terraform is not detected",
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+)
+
+// ValidatePGPPrivateKey validates that the value is an armored PGP private
+// key. The passphrase is checked by the resources at plan time.
+func ValidatePGPPrivateKey(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := tools.ReadPGPPrivateKey(v); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+	return nil, nil
+}
@@ -16,7 +16,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"blob_store_name": {
-					Description: "Blob store used to store repository contents",
+					Description: "Blob store used to store repository contents. Changing it moves the content with the Nexus Pro task `Admin - Change repository blob store` and waits for it up to the `update` timeout, on Nexus OSS the repository has to be recreated",
 					Required:    true,
 					Set: func(v interface{}) int {
 						return schema.HashString(strings.ToLower(v.(string)))
@@ -60,7 +60,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"blob_store_name": {
-					Description: "Blob store used to store repository contents",
+					Description: "Blob store used to store repository contents. Changing it moves the content with the Nexus Pro task `Admin - Change repository blob store` and waits for it up to the `update` timeout, on Nexus OSS the repository has to be recreated",
 					Required:    true,
 					Set: func(v interface{}) int {
 						return schema.HashString(strings.ToLower(v.(string)))
@@ -13,10 +13,11 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"keypair": {
-					Description: "PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)",
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
+					Description:  "PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)",
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: ValidatePGPPrivateKey,
 				},
 				"passphrase": {
 					Description: "Passphrase to access PGP signing key",
@@ -1,8 +1,11 @@
 package blobstore
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,7 +15,7 @@ func DataSourceBlobstoreAzure() *schema.Resource {
 
 Use this data source to get details of an existing Nexus Azure blobstore.`,
 
-		Read: dataSourceBlobstoreAzureRead,
+		ReadContext: dataSourceBlobstoreAzureRead,
 		Schema: map[string]*schema.Schema{
 			"id":                  common.DataSourceID,
 			"name":                blobstore.DataSourceName,
@@ -33,7 +36,7 @@ Use this data source to get details of an existing Nexus Azure blobstore.`,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"authentication_method": {
-										Description: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY` and `MANAGEDIDENTITY`",
+										Description: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY`, `MANAGEDIDENTITY` and `ENVIRONMENTVARIABLE`",
 										Computed:    true,
 										Type:        schema.TypeString,
 									},
@@ -56,8 +59,8 @@ Use this data source to get details of an existing Nexus Azure blobstore.`,
 	}
 }
 
-func dataSourceBlobstoreAzureRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceBlobstoreAzureRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceBlobstoreAzureRead(resourceData, m)
+	return resourceBlobstoreAzureRead(ctx, resourceData, m)
 }
@@ -1,8 +1,11 @@
 package blobstore
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,7 +13,7 @@ func DataSourceBlobstoreFile() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to get details of an existing Nexus File blobstore.",
 
-		Read: dataSourceBlobstoreFileRead,
+		ReadContext: dataSourceBlobstoreFileRead,
 		Schema: map[string]*schema.Schema{
 			"id":   common.DataSourceID,
 			"name": blobstore.DataSourceName,
@@ -19,6 +22,11 @@ func DataSourceBlobstoreFile() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"absolute_path": {
+				Description: "The absolute path to the blobstore contents on the Nexus server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"available_space_in_bytes": blobstore.DataSourceAvailableSpaceInBytes,
 			"blob_count":               blobstore.DataSourceBlobCount,
 			"soft_quota":               blobstore.DataSourceSoftQuota,
@@ -27,8 +35,8 @@ func DataSourceBlobstoreFile() *schema.Resource {
 	}
 }
 
-func dataSourceBlobstoreFileRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceBlobstoreFileRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceBlobstoreFileRead(resourceData, m)
+	return resourceBlobstoreFileRead(ctx, resourceData, m)
 }
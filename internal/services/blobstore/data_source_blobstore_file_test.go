@@ -22,6 +22,7 @@ func TestAccDataSourceBlobstoreFile(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "id", "default"),
 					resource.TestCheckResourceAttr(dataSourceName, "name", "default"),
 					resource.TestCheckResourceAttr(dataSourceName, "path", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "absolute_path", "/nexus-data/blobs/default"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "blob_count"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "total_size_in_bytes"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "available_space_in_bytes"),
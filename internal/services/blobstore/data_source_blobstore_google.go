@@ -0,0 +1,86 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceBlobstoreGoogle() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this data source to get details of an existing Nexus Google Cloud Storage blobstore.`,
+
+		ReadContext: dataSourceBlobstoreGoogleRead,
+		Schema: map[string]*schema.Schema{
+			"id":                  common.DataSourceID,
+			"name":                blobstore.DataSourceName,
+			"blob_count":          blobstore.DataSourceBlobCount,
+			"soft_quota":          blobstore.DataSourceSoftQuota,
+			"total_size_in_bytes": blobstore.DataSourceTotalSizeInBytes,
+			"bucket_configuration": {
+				Description: "The Google Cloud Storage bucket configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Description: "The Google Cloud Storage bucket configuration",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "The name of the Google Cloud Storage bucket",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"region": {
+										Description: "The GCP region to create a new bucket in or an existing bucket's region",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"prefix": {
+										Description: "The blob store (i.e. object name) prefix within the bucket",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Computed: true,
+							Type:     schema.TypeList,
+						},
+						"bucket_security": {
+							Description: "The credentials to access the bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_method": {
+										Description: "The authentication method. Possible values: `accountKey` or `applicationDefault`",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"account_key": {
+										Description: "The content of the JSON credential file of the service account",
+										Computed:    true,
+										Sensitive:   true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Computed: true,
+							Type:     schema.TypeList,
+						},
+					},
+				},
+				Computed: true,
+				Type:     schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceBlobstoreGoogleRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceData.SetId(resourceData.Get("name").(string))
+
+	return resourceBlobstoreGoogleRead(ctx, resourceData, m)
+}
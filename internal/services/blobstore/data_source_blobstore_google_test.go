@@ -0,0 +1,47 @@
+package blobstore_test
+
+import (
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceBlobstoreGoogle(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+	if tools.GetEnv("SKIP_GOOGLE_TESTS", "false") == "true" {
+		t.Skip("Skipping Google Cloud Storage tests")
+	}
+
+	dataSourceName := "data.nexus_blobstore_google.acceptance"
+
+	bs := testAccBlobstoreGoogle()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreGoogleConfig(bs) + testAccDataSourceBlobstoreGoogleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", bs.Name),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.bucket.0.name", bs.BucketConfiguration.Bucket.Name),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.bucket.0.region", bs.BucketConfiguration.Bucket.Region),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.bucket.0.prefix", bs.BucketConfiguration.Bucket.Prefix),
+					resource.TestCheckResourceAttrSet(dataSourceName, "blob_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_size_in_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceBlobstoreGoogleConfig() string {
+	return `
+data "nexus_blobstore_google" "acceptance" {
+	name = nexus_blobstore_google.acceptance.name
+}`
+}
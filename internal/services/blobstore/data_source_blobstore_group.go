@@ -1,9 +1,12 @@
 package blobstore
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,7 +16,7 @@ func DataSourceBlobstoreGroup() *schema.Resource {
 
 Use this data source to get details of an existing Nexus Group blobstore.`,
 
-		Read: dataSourceBlobstoreGroupRead,
+		ReadContext: dataSourceBlobstoreGroupRead,
 		Schema: map[string]*schema.Schema{
 			"id":                       common.DataSourceID,
 			"name":                     blobstore.DataSourceName,
@@ -38,8 +41,8 @@ Use this data source to get details of an existing Nexus Group blobstore.`,
 	}
 }
 
-func dataSourceBlobstoreGroupRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceBlobstoreGroupRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceBlobstoreGroupRead(resourceData, m)
+	return resourceBlobstoreGroupRead(ctx, resourceData, m)
 }
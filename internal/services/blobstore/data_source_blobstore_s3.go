@@ -1,8 +1,11 @@
 package blobstore
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,7 +13,7 @@ func DataSourceBlobstoreS3() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to get details of an existing Nexus S3 blobstore.",
 
-		Read: dataSourceBlobstoreS3Read,
+		ReadContext: dataSourceBlobstoreS3Read,
 		Schema: map[string]*schema.Schema{
 			"id":                  common.DataSourceID,
 			"name":                blobstore.DataSourceName,
@@ -133,8 +136,8 @@ func DataSourceBlobstoreS3() *schema.Resource {
 	}
 }
 
-func dataSourceBlobstoreS3Read(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceBlobstoreS3Read(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceBlobstoreS3Read(resourceData, m)
+	return resourceBlobstoreS3Read(ctx, resourceData, m)
 }
@@ -0,0 +1,128 @@
+package blobstore
+
+import (
+	"context"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceBlobstores() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get a list with all blobstores including their usage and soft quota status.",
+
+		ReadContext: dataSourceBlobstoresRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"items": {
+				Description: "A list of all blobstores",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Blobstore name",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"type": {
+							Description: "Blobstore type, e.g. `File`, `S3`, `Azure Cloud Storage` or `Group`",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"unavailable": {
+							Description: "Whether the blobstore is unavailable",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						"available_space_in_bytes": {
+							Description: "Available space in Bytes",
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						"blob_count": {
+							Description: "Count of blobs",
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						"total_size_in_bytes": {
+							Description: "The total size of the blobstore in Bytes",
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						"soft_quota_limit": {
+							Description: "The limit of the soft quota in Bytes. `0` if the blobstore has no soft quota",
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						"soft_quota_type": {
+							Description: "The type of the soft quota, `spaceRemainingQuota` or `spaceUsedQuota`. Empty if the blobstore has no soft quota",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"quota_violated": {
+							Description: "Whether the blobstore violates its soft quota",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						"quota_message": {
+							Description: "The message of the soft quota check",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlobstoresRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+	client := api.NewClient(m)
+
+	blobstores, err := nexusClient.BlobStore.List()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(blobstores))
+	for _, bs := range blobstores {
+		item := map[string]interface{}{
+			"name":                     bs.Name,
+			"type":                     bs.Type,
+			"unavailable":              bs.Unavailable,
+			"available_space_in_bytes": bs.AvailableSpaceInBytes,
+			"blob_count":               bs.BlobCount,
+			"total_size_in_bytes":      bs.TotalSizeInBytes,
+			"soft_quota_limit":         0,
+			"soft_quota_type":          "",
+			"quota_violated":           false,
+			"quota_message":            "",
+		}
+
+		if bs.SoftQuota != nil {
+			item["soft_quota_limit"] = int(bs.SoftQuota.Limit)
+			item["soft_quota_type"] = bs.SoftQuota.Type
+
+			status, err := client.GetBlobstoreQuotaStatus(ctx, bs.Name)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			item["quota_violated"] = status.IsViolation
+			item["quota_message"] = status.Message
+		}
+
+		items = append(items, item)
+	}
+
+	if err := resourceData.Set("items", items); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceData.SetId("blobstores")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceBlobstores(t *testing.T) {
+	dataSourceName := "data.nexus_blobstores.acceptance"
+
+	bs := blobstore.File{
+		Name: fmt.Sprintf("test-blobstore-%s", acctest.RandString(5)),
+		Path: "/nexus-data/acceptance-blobstores",
+		SoftQuota: &blobstore.SoftQuota{
+			Limit: int64(acctest.RandIntRange(100, 300) * 1000000),
+			Type:  "spaceUsedQuota",
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreFileConfig(bs) + `
+data "nexus_blobstores" "acceptance" {
+	depends_on = [nexus_blobstore_file.acceptance]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "items.*", map[string]string{
+						"name":             bs.Name,
+						"type":             "File",
+						"soft_quota_limit": fmt.Sprintf("%d", bs.SoftQuota.Limit),
+						"soft_quota_type":  bs.SoftQuota.Type,
+						"quota_violated":   "false",
+					}),
+				),
+			},
+		},
+	})
+}
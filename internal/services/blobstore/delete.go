@@ -0,0 +1,120 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	blobstoreTypeFile  = "file"
+	blobstoreTypeGroup = "group"
+
+	// blobstoreDeleteDescription completes the description of all blob store
+	// resources
+	blobstoreDeleteDescription = `
+
+Destroying the blobstore fails while repositories use it or group blobstores contain it, the error lists them.
+There is no ` + "`force_destroy`" + `, as Nexus refuses to delete a blobstore which any repository references, even an offline one.`
+)
+
+// deleteBlobstore deletes the blob store of the resource after checking that
+// no repository and no group blob store uses it. ctx is expected to carry the
+// blob store log subsystem.
+func deleteBlobstore(ctx context.Context, resourceData *schema.ResourceData, m interface{}, deleteFunc func(name string) error) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+	name := resourceData.Id()
+
+	repositories, err := getBlobstoreRepositories(ctx, api.NewClient(m), name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	groups, err := getBlobstoreGroups(nexusClient, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(groups) > 0 || len(repositories) > 0 {
+		return blobstoreInUseDiagnostics(name, repositories, groups)
+	}
+
+	if err := deleteFunc(name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+// getBlobstoreRepositories returns all repositories storing their content in
+// the given blob store
+func getBlobstoreRepositories(ctx context.Context, client *api.Client, name string) ([]api.RepositorySettings, error) {
+	repositories, err := client.ListRepositorySettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []api.RepositorySettings
+	for _, repository := range repositories {
+		if repository.Storage != nil && repository.Storage.BlobStoreName == name {
+			result = append(result, repository)
+		}
+	}
+	return result, nil
+}
+
+// getBlobstoreGroups returns the names of all group blob stores the given blob
+// store is a member of
+func getBlobstoreGroups(nexusClient *nexus.NexusClient, name string) ([]string, error) {
+	blobstores, err := nexusClient.BlobStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []string
+	for _, bs := range blobstores {
+		if !strings.EqualFold(bs.Type, blobstoreTypeGroup) || bs.Name == name {
+			continue
+		}
+		group, err := nexusClient.BlobStore.Group.Get(bs.Name)
+		if err != nil {
+			return nil, err
+		}
+		if group != nil && tools.ContainsString(group.Members, name) {
+			groups = append(groups, group.Name)
+		}
+	}
+	return groups, nil
+}
+
+func getRepositoryNames(repositories []api.RepositorySettings) []string {
+	names := make([]string, len(repositories))
+	for i, repository := range repositories {
+		names[i] = repository.Name
+	}
+	return names
+}
+
+func blobstoreInUseDiagnostics(name string, repositories []api.RepositorySettings, groups []string) diag.Diagnostics {
+	var details []string
+	if len(repositories) > 0 {
+		details = append(details, fmt.Sprintf("It is used by the repositories: %s", strings.Join(getRepositoryNames(repositories), ", ")))
+	}
+	if len(groups) > 0 {
+		details = append(details, fmt.Sprintf("It is a member of the group blobstores: %s", strings.Join(groups, ", ")))
+	}
+	details = append(details, "Delete these repositories or move them to another blobstore and remove the blobstore from all groups first.")
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Blobstore %q is in use", name),
+		Detail:   strings.Join(details, "\n"),
+	}}
+}
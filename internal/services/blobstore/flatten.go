@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,7 +48,7 @@ func flattenS3BucketConfiguration(bucketConfig *blobstore.S3BucketConfiguration,
 			"advanced_bucket_connection": flattenAdvancedBucketConnection(bucketConfig.AdvancedBucketConnection),
 			"bucket":                     flattenBucket(bucketConfig.Bucket),
 			"bucket_security":            flattenBucketSecurity(bucketConfig.BucketSecurity, resourceData),
-			"encryption":                 flattenEncryption(bucketConfig.Encryption),
+			"encryption":                 flattenEncryption(bucketConfig.Encryption, resourceData),
 		},
 	}
 }
@@ -90,8 +91,17 @@ func flattenBucketSecurity(bucketSecurity *blobstore.S3BucketSecurity, resourceD
 	}
 }
 
-func flattenEncryption(encryption *blobstore.S3Encryption) []map[string]interface{} {
+func flattenEncryption(encryption *blobstore.S3Encryption, resourceData *schema.ResourceData) []map[string]interface{} {
 	if encryption == nil {
+		// Nexus omits the encryption if its type is none
+		if resourceData.Get("bucket_configuration.0.encryption.0.encryption_type") == s3EncryptionTypeNone {
+			return []map[string]interface{}{
+				{
+					"encryption_key":  "",
+					"encryption_type": s3EncryptionTypeNone,
+				},
+			}
+		}
 		return nil
 	}
 	return []map[string]interface{}{
@@ -101,3 +111,34 @@ func flattenEncryption(encryption *blobstore.S3Encryption) []map[string]interfac
 		},
 	}
 }
+
+func flattenGoogleBucketConfiguration(bucketConfig *api.GoogleBucketConfiguration, resourceData *schema.ResourceData) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"bucket": []map[string]interface{}{
+				{
+					"name":   bucketConfig.Bucket.Name,
+					"prefix": bucketConfig.Bucket.Prefix,
+					"region": bucketConfig.Bucket.Region,
+				},
+			},
+			"bucket_security": flattenGoogleBucketSecurity(bucketConfig.BucketSecurity, resourceData),
+		},
+	}
+}
+
+func flattenGoogleBucketSecurity(bucketSecurity *api.GoogleBucketSecurity, resourceData *schema.ResourceData) []map[string]interface{} {
+	if bucketSecurity == nil {
+		return nil
+	}
+	// Application default credentials are used when the block is omitted
+	if _, ok := resourceData.GetOk("bucket_configuration.0.bucket_security"); !ok && bucketSecurity.AuthenticationMethod == api.GoogleAuthenticationMethodApplicationDefault {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"authentication_method": bucketSecurity.AuthenticationMethod,
+			"account_key":           resourceData.Get("bucket_configuration.0.bucket_security.0.account_key"),
+		},
+	}
+}
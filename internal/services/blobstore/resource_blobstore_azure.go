@@ -1,31 +1,38 @@
 package blobstore
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// azureAuthenticationMethodEnvironmentVariable lets Nexus read the credentials
+// of a service principal from the AZURE_* environment variables
+const azureAuthenticationMethodEnvironmentVariable blobstore.AzureAuthenticationMethod = "ENVIRONMENTVARIABLE"
+
 func ResourceBlobstoreAzure() *schema.Resource {
 	return &schema.Resource{
 		Description: `~> PRO Feature
 
-Use this resource to create a Nexus Azure blobstore.`,
+Use this resource to create a Nexus Azure blobstore.` + blobstoreDeleteDescription,
 
-		Create: resourceBlobstoreAzureCreate,
-		Read:   resourceBlobstoreAzureRead,
-		Update: resourceBlobstoreAzureUpdate,
-		Delete: resourceBlobstoreAzureDelete,
-		Exists: resourceBlobstoreAzureExists,
+		CreateContext: resourceBlobstoreAzureCreate,
+		ReadContext:   resourceBlobstoreAzureRead,
+		UpdateContext: resourceBlobstoreAzureUpdate,
+		DeleteContext: resourceBlobstoreAzureDelete,
+		Exists:        resourceBlobstoreAzureExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceBlobstoreAzureCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id":                  common.ResourceID,
@@ -47,14 +54,20 @@ Use this resource to create a Nexus Azure blobstore.`,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"authentication_method": {
-										Description:  "The type of Azure authentication to use. Possible values: `ACCOUNTKEY` and `MANAGEDIDENTITY`",
-										Required:     true,
-										Type:         schema.TypeString,
-										ValidateFunc: validation.StringInSlice([]string{string(blobstore.AzureAuthenticationMethodAccountKey), string(blobstore.AzureAuthenticationMethodManagedIdentity)}, false),
+										Description: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY`, `MANAGEDIDENTITY` (the managed identity of the Nexus host, e.g. an AKS workload identity) and `ENVIRONMENTVARIABLE` (a service principal configured with the `AZURE_*` environment variables of Nexus). " +
+											"Nexus does not support SAS tokens",
+										Required: true,
+										Type:     schema.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(blobstore.AzureAuthenticationMethodAccountKey),
+											string(blobstore.AzureAuthenticationMethodManagedIdentity),
+											string(azureAuthenticationMethodEnvironmentVariable),
+										}, false),
 									},
 									"account_key": {
-										Description: "The account key. Required if `authentication_method` is `ACCOUNTKEY`",
+										Description: "The account key. Required if `authentication_method` is `ACCOUNTKEY`, not allowed otherwise",
 										Optional:    true,
+										Sensitive:   true,
 										Type:        schema.TypeString,
 									},
 								},
@@ -110,34 +123,53 @@ func getBlobstoreAzureFromResourceData(d *schema.ResourceData) blobstore.Azure {
 	return bs
 }
 
-func resourceBlobstoreAzureCreate(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreAzureCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
 
 	bs := getBlobstoreAzureFromResourceData(resourceData)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_azure", ResourceBlobstoreAzure, map[string]interface{}{
+		"name": bs.Name,
+		"type": "azure",
+	})
 
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Creating blob store")
 	if err := nexusClient.BlobStore.Azure.Create(&bs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(bs.Name)
 	resourceData.Set("name", bs.Name)
 
-	return resourceBlobstoreAzureRead(resourceData, m)
+	return resourceBlobstoreAzureRead(ctx, resourceData, m)
 }
 
-func resourceBlobstoreAzureRead(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreAzureRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_azure", ResourceBlobstoreAzure, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "azure",
+	})
 
 	bs, err := nexusClient.BlobStore.Azure.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
+	if bs == nil {
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Blob store not found, removing it from state")
+		resourceData.SetId("")
+		return nil
+	}
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Read blob store", map[string]interface{}{
+		"account_name":          bs.BucketConfiguration.AccountName,
+		"authentication_method": bs.BucketConfiguration.Authentication.AuthenticationMethod,
+		"container_name":        bs.BucketConfiguration.ContainerName,
+	})
+
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -145,54 +177,65 @@ func resourceBlobstoreAzureRead(resourceData *schema.ResourceData, m interface{}
 		}
 	}
 
-	if bs == nil {
-		resourceData.SetId("")
-		return nil
-	}
-
 	if err := resourceData.Set("name", bs.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("blob_count", genericBlobstoreInformation.BlobCount); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("total_size_in_bytes", genericBlobstoreInformation.TotalSizeInBytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("bucket_configuration", flattenAzureBucketConfiguration(&bs.BucketConfiguration, resourceData)); err != nil {
-		return fmt.Errorf("error reading bucket configuration: %s", err)
+		return diag.Errorf("error reading bucket configuration: %s", err)
 	}
 
 	if bs.SoftQuota != nil {
 		if err := resourceData.Set("soft_quota", flattenSoftQuota(bs.SoftQuota)); err != nil {
-			return fmt.Errorf("error reading soft quota: %s", err)
+			return diag.Errorf("error reading soft quota: %s", err)
 		}
 	}
 
 	return nil
 }
 
-func resourceBlobstoreAzureUpdate(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreAzureUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_azure", ResourceBlobstoreAzure, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "azure",
+	})
 
 	bs := getBlobstoreAzureFromResourceData(resourceData)
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Updating blob store")
 	if err := nexusClient.BlobStore.Azure.Update(resourceData.Id(), &bs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceBlobstoreAzureDelete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
-
-	if err := nexusClient.BlobStore.Azure.Delete(resourceData.Id()); err != nil {
-		return err
+// resourceBlobstoreAzureCustomizeDiff validates the authentication settings as
+// soon as they are known
+func resourceBlobstoreAzureCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("bucket_configuration.0.authentication.0.authentication_method") || !diff.NewValueKnown("bucket_configuration.0.authentication.0.account_key") {
+		return nil
 	}
 
-	resourceData.SetId("")
+	return validateAzureAuthentication(
+		blobstore.AzureAuthenticationMethod(diff.Get("bucket_configuration.0.authentication.0.authentication_method").(string)),
+		diff.Get("bucket_configuration.0.authentication.0.account_key").(string),
+	)
+}
 
-	return nil
+func resourceBlobstoreAzureDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_azure", ResourceBlobstoreAzure, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "azure",
+	})
+
+	return deleteBlobstore(ctx, resourceData, m, nexusClient.BlobStore.Azure.Delete)
 }
 
 func resourceBlobstoreAzureExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
@@ -2,6 +2,7 @@ package blobstore_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
@@ -75,3 +76,42 @@ resource "nexus_blobstore_azure" "acceptance" {
 	}
 }`, bs.Name, bs.BucketConfiguration.AccountName, bs.BucketConfiguration.Authentication.AuthenticationMethod, bs.BucketConfiguration.Authentication.AccountKey, bs.BucketConfiguration.ContainerName)
 }
+
+func TestAccResourceBlobstoreAzureAuthenticationValidation(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	bs := blobstore.Azure{
+		Name: fmt.Sprintf("test-blobstore-azure-%s", acctest.RandString(5)),
+		BucketConfiguration: blobstore.AzureBucketConfiguration{
+			AccountName: "terraformprovidernexus",
+			Authentication: blobstore.AzureBucketConfigurationAuthentication{
+				AuthenticationMethod: blobstore.AzureAuthenticationMethodAccountKey,
+			},
+			ContainerName: "acceptance",
+		},
+	}
+	managedIdentity := bs
+	managedIdentity.BucketConfiguration.Authentication = blobstore.AzureBucketConfigurationAuthentication{
+		AuthenticationMethod: blobstore.AzureAuthenticationMethodManagedIdentity,
+		AccountKey:           "test-key",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceBlobstoreTypeAzureConfig(bs),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("account_key is required"),
+			},
+			{
+				Config:      testAccResourceBlobstoreTypeAzureConfig(managedIdentity),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("account_key is not supported"),
+			},
+		},
+	})
+}
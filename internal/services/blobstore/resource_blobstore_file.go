@@ -1,36 +1,54 @@
 package blobstore
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"path"
+	"strings"
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// blobstoreFileBlobsDirectory is the directory within the Nexus working
+// directory relative file blobstore paths are resolved against
+const blobstoreFileBlobsDirectory = "blobs"
+
 func ResourceBlobstoreFile() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create a Nexus file blobstore.",
+		Description: "Use this resource to create a Nexus file blobstore." + blobstoreDeleteDescription,
 
-		Create: resourceBlobstoreFileCreate,
-		Read:   resourceBlobstoreFileRead,
-		Update: resourceBlobstoreFileUpdate,
-		Delete: resourceBlobstoreFileDelete,
-		Exists: resourceBlobstoreFileExists,
+		CreateContext: resourceBlobstoreFileCreate,
+		ReadContext:   resourceBlobstoreFileRead,
+		UpdateContext: resourceBlobstoreFileUpdate,
+		DeleteContext: resourceBlobstoreFileDelete,
+		Exists:        resourceBlobstoreFileExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceBlobstoreFileCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id":   common.ResourceID,
 			"name": blobstoreSchema.ResourceName,
 			"path": {
-				Description: "The path to the blobstore contents. This can be an absolute path to anywhere on the system nxrm has access to or it can be a path relative to the sonatype-work directory",
+				Description:      "The path to the blobstore contents. This can be an absolute path to anywhere on the system nxrm has access to or it can be a path relative to the `blobs` directory within the sonatype-work directory. Defaults to the name of the blobstore. Must not overlap with the path of another file blobstore",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressEquivalentBlobstoreFilePath,
+			},
+			"absolute_path": {
+				Description: "The absolute path to the blobstore contents on the Nexus server. Empty if the provider user may not read the Nexus system information",
 				Type:        schema.TypeString,
-				Optional:    true,
+				Computed:    true,
 			},
 			"available_space_in_bytes": blobstoreSchema.ResourceAvailableSpaceInBytes,
 			"blob_count":               blobstoreSchema.ResourceBlobCount,
@@ -43,6 +61,7 @@ func ResourceBlobstoreFile() *schema.Resource {
 func getBlobstoreFileFromResourceData(resourceData *schema.ResourceData) blobstore.File {
 	bs := blobstore.File{
 		Name: resourceData.Get("name").(string),
+		Path: resourceData.Get("name").(string),
 	}
 
 	if _, ok := resourceData.GetOk("path"); ok {
@@ -61,37 +80,66 @@ func getBlobstoreFileFromResourceData(resourceData *schema.ResourceData) blobsto
 	return bs
 }
 
-func resourceBlobstoreFileCreate(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreFileCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
 
 	bs := getBlobstoreFileFromResourceData(resourceData)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_file", ResourceBlobstoreFile, map[string]interface{}{
+		"name": bs.Name,
+		"type": "file",
+	})
 
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Creating blob store")
 	if err := nexusClient.BlobStore.File.Create(&bs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(bs.Name)
 	err := resourceData.Set("name", bs.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceBlobstoreFileRead(resourceData, m)
+	return resourceBlobstoreFileRead(ctx, resourceData, m)
 }
 
-func resourceBlobstoreFileRead(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreFileRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_file", ResourceBlobstoreFile, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "file",
+	})
 
 	bs, err := nexusClient.BlobStore.File.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	if bs == nil {
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Blob store not found, removing it from state")
+		resourceData.SetId("")
+		return nil
+	}
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Read blob store", map[string]interface{}{
+		"path": bs.Path,
+	})
+
+	// Keep the path as configured if Nexus returns it in another form
+	blobstorePath := bs.Path
+	absolutePath := ""
+	if blobsDirectory, ok := getBlobstoreFileBlobsDirectory(ctx, m); ok {
+		absolutePath = resolveBlobstoreFilePath(blobsDirectory, bs.Path)
+		if statePath, ok := resourceData.GetOk("path"); ok && resolveBlobstoreFilePath(blobsDirectory, statePath.(string)) == absolutePath {
+			blobstorePath = statePath.(string)
+		}
+	} else if path.IsAbs(bs.Path) {
+		absolutePath = path.Clean(bs.Path)
 	}
 
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -99,57 +147,152 @@ func resourceBlobstoreFileRead(resourceData *schema.ResourceData, m interface{})
 		}
 	}
 
-	if bs == nil {
-		resourceData.SetId("")
-		return nil
-	}
-
 	if err := resourceData.Set("available_space_in_bytes", genericBlobstoreInformation.AvailableSpaceInBytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("blob_count", genericBlobstoreInformation.BlobCount); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("name", bs.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	if err := resourceData.Set("path", bs.Path); err != nil {
-		return err
+	if err := resourceData.Set("path", blobstorePath); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceData.Set("absolute_path", absolutePath); err != nil {
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("total_size_in_bytes", genericBlobstoreInformation.TotalSizeInBytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bs.SoftQuota != nil {
 		if err := resourceData.Set("soft_quota", flattenSoftQuota(bs.SoftQuota)); err != nil {
-			return fmt.Errorf("error reading soft quota: %s", err)
+			return diag.Errorf("error reading soft quota: %s", err)
 		}
 	}
 
 	return nil
 }
 
-func resourceBlobstoreFileUpdate(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreFileUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_file", ResourceBlobstoreFile, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "file",
+	})
 
 	bs := getBlobstoreFileFromResourceData(resourceData)
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Updating blob store")
 	if err := nexusClient.BlobStore.File.Update(resourceData.Id(), &bs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceBlobstoreFileDelete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+// resourceBlobstoreFileCustomizeDiff rejects paths which overlap with the path
+// of another file blobstore and plans the resolved absolute path
+func resourceBlobstoreFileCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	configPath := diff.GetRawConfig().GetAttr("path")
+	if !diff.NewValueKnown("name") || !configPath.IsKnown() || (diff.Id() != "" && !diff.HasChange("path")) {
+		return nil
+	}
+
+	name := diff.Get("name").(string)
+	blobstorePath := name
+	if !configPath.IsNull() {
+		blobstorePath = configPath.AsString()
+	}
+
+	blobsDirectory, ok := getBlobstoreFileBlobsDirectory(ctx, m)
+	if !ok {
+		return nil
+	}
+	absolutePath := resolveBlobstoreFilePath(blobsDirectory, blobstorePath)
 
-	if err := nexusClient.BlobStore.File.Delete(resourceData.Id()); err != nil {
+	nexusClient := m.(*nexus.NexusClient)
+	blobstores, err := nexusClient.BlobStore.List()
+	if err != nil {
 		return err
 	}
+	for _, generic := range blobstores {
+		if !strings.EqualFold(generic.Type, blobstoreTypeFile) || generic.Name == name || generic.Name == diff.Id() {
+			continue
+		}
+		other, err := nexusClient.BlobStore.File.Get(generic.Name)
+		if err != nil {
+			return err
+		}
+		if other == nil {
+			continue
+		}
+		otherPath := resolveBlobstoreFilePath(blobsDirectory, other.Path)
+		if blobstoreFilePathsOverlap(absolutePath, otherPath) {
+			return fmt.Errorf("path %q (%s) overlaps with the path %q (%s) of the file blobstore %q", blobstorePath, absolutePath, other.Path, otherPath, other.Name)
+		}
+	}
 
-	resourceData.SetId("")
+	return diff.SetNew("absolute_path", absolutePath)
+}
 
-	return nil
+// getBlobstoreFileBlobsDirectory returns the directory relative paths of file
+// blobstores are resolved against. Reading it requires the nx-atlas-all
+// privilege, so failures are logged only.
+func getBlobstoreFileBlobsDirectory(ctx context.Context, m interface{}) (string, bool) {
+	info, err := api.NewClient(m).GetSystemInformation(ctx)
+	if err != nil || info.Configuration.WorkingDirectory == "" {
+		tflog.SubsystemWarn(ctx, tools.LogSubsystemBlobstore, "Could not read the Nexus working directory, file blobstore paths are not normalized", map[string]interface{}{
+			"error": fmt.Sprint(err),
+		})
+		return "", false
+	}
+	return path.Join(info.Configuration.WorkingDirectory, blobstoreFileBlobsDirectory), true
+}
+
+// resolveBlobstoreFilePath returns the absolute path of a file blobstore path
+func resolveBlobstoreFilePath(blobsDirectory string, blobstorePath string) string {
+	if path.IsAbs(blobstorePath) {
+		return path.Clean(blobstorePath)
+	}
+	return path.Join(blobsDirectory, blobstorePath)
+}
+
+func blobstoreFilePathsOverlap(a string, b string) bool {
+	return a == b || strings.HasPrefix(a, strings.TrimSuffix(b, "/")+"/") || strings.HasPrefix(b, strings.TrimSuffix(a, "/")+"/")
+}
+
+// suppressEquivalentBlobstoreFilePath suppresses the diff between a relative
+// and an absolute path which resolve to the same absolute path. The blobs
+// directory is derived from the absolute path read from Nexus.
+func suppressEquivalentBlobstoreFilePath(k, old, new string, d *schema.ResourceData) bool {
+	absolutePath := d.Get("absolute_path").(string)
+	if old == "" || new == "" || absolutePath == "" {
+		return false
+	}
+
+	var blobsDirectory string
+	switch {
+	case !path.IsAbs(old):
+		blobsDirectory = strings.TrimSuffix(absolutePath, "/"+path.Clean(old))
+	case !path.IsAbs(new):
+		blobsDirectory = strings.TrimSuffix(absolutePath, "/"+path.Clean(new))
+		if path.Base(blobsDirectory) != blobstoreFileBlobsDirectory {
+			return false
+		}
+	}
+
+	return resolveBlobstoreFilePath(blobsDirectory, old) == absolutePath && resolveBlobstoreFilePath(blobsDirectory, new) == absolutePath
+}
+
+func resourceBlobstoreFileDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_file", ResourceBlobstoreFile, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "file",
+	})
+
+	return deleteBlobstore(ctx, resourceData, m, nexusClient.BlobStore.File.Delete)
 }
 
 func resourceBlobstoreFileExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
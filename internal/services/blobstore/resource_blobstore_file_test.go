@@ -3,6 +3,7 @@ package blobstore_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
@@ -58,6 +59,7 @@ func TestAccResourceBlobstoreFile(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "id", bs.Name),
 					resource.TestCheckResourceAttr(resourceName, "name", bs.Name),
 					resource.TestCheckResourceAttr(resourceName, "path", bs.Path),
+					resource.TestCheckResourceAttr(resourceName, "absolute_path", bs.Path),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "soft_quota.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "soft_quota.0.limit", strconv.FormatInt(bs.SoftQuota.Limit, 10)),
@@ -78,3 +80,90 @@ func TestAccResourceBlobstoreFile(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceBlobstoreFileInUse(t *testing.T) {
+	name := fmt.Sprintf("test-blobstore-%s", acctest.RandString(5))
+	repositoryName := fmt.Sprintf("test-repo-%s", acctest.RandString(5))
+
+	blobstoreConfig := fmt.Sprintf(`
+resource "nexus_blobstore_file" "acceptance" {
+	name = "%s"
+	path = "/nexus-data/acceptance-in-use"
+}`, name)
+	repositoryConfig := func(dependsOn string) string {
+		return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name   = "%s"
+	online = true
+
+	storage {
+		blob_store_name                = "%s"
+		strict_content_type_validation = false
+		write_policy                   = "ALLOW"
+	}
+	%s
+}`, repositoryName, name, dependsOn)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: blobstoreConfig,
+			},
+			{
+				Config: blobstoreConfig + repositoryConfig(""),
+			},
+			{
+				Config:      repositoryConfig(""),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Blobstore \"%s\" is in use", name)),
+			},
+			{
+				// Restore the dependency, so the repository gets destroyed first
+				Config: blobstoreConfig + repositoryConfig("depends_on = [nexus_blobstore_file.acceptance]"),
+			},
+		},
+	})
+}
+
+func TestAccResourceBlobstoreFileRelativePath(t *testing.T) {
+	resourceName := "nexus_blobstore_file.acceptance"
+
+	bs := blobstore.File{
+		Name: fmt.Sprintf("test-blobstore-%s", acctest.RandString(5)),
+	}
+	bs.Path = bs.Name
+	absolute := blobstore.File{
+		Name: bs.Name,
+		Path: fmt.Sprintf("/nexus-data/blobs/%s", bs.Name),
+	}
+	nestedConfig := fmt.Sprintf(`
+resource "nexus_blobstore_file" "nested" {
+	name = "%s-nested"
+	path = "%s/nested"
+}`, bs.Name, bs.Name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreFileConfig(bs),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", bs.Path),
+					resource.TestCheckResourceAttr(resourceName, "absolute_path", absolute.Path),
+				),
+			},
+			{
+				// The absolute path of the blobstore must not cause a diff
+				Config:   testAccResourceBlobstoreFileConfig(absolute),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccResourceBlobstoreFileConfig(bs) + nestedConfig,
+				ExpectError: regexp.MustCompile("overlaps with the path"),
+			},
+		},
+	})
+}
@@ -0,0 +1,251 @@
+package blobstore
+
+import (
+	"context"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceBlobstoreGoogle() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to create a Nexus Google Cloud Storage blobstore.` + blobstoreDeleteDescription,
+
+		CreateContext: resourceBlobstoreGoogleCreate,
+		ReadContext:   resourceBlobstoreGoogleRead,
+		UpdateContext: resourceBlobstoreGoogleUpdate,
+		DeleteContext: resourceBlobstoreGoogleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceBlobstoreGoogleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id":                  common.ResourceID,
+			"name":                blobstoreSchema.ResourceName,
+			"blob_count":          blobstoreSchema.ResourceBlobCount,
+			"soft_quota":          blobstoreSchema.ResourceSoftQuota,
+			"total_size_in_bytes": blobstoreSchema.ResourceTotalSizeInBytes,
+			"bucket_configuration": {
+				Description: "The Google Cloud Storage bucket configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Description: "The Google Cloud Storage bucket configuration",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "The name of the Google Cloud Storage bucket",
+										Required:    true,
+										Type:        schema.TypeString,
+									},
+									"region": {
+										Description: "The GCP region to create a new bucket in or an existing bucket's region",
+										Required:    true,
+										Type:        schema.TypeString,
+									},
+									"prefix": {
+										Description: "The blob store (i.e. object name) prefix within the bucket",
+										Optional:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							MaxItems: 1,
+							Required: true,
+							Type:     schema.TypeList,
+						},
+						"bucket_security": {
+							Description: "The credentials to access the bucket. Without this block the application default credentials of the Nexus server are used",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_method": {
+										Default:      api.GoogleAuthenticationMethodAccountKey,
+										Description:  "The authentication method. Possible values: `accountKey` or `applicationDefault`",
+										Optional:     true,
+										Type:         schema.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{api.GoogleAuthenticationMethodAccountKey, api.GoogleAuthenticationMethodApplicationDefault}, false),
+									},
+									"account_key": {
+										Description:  "The content of the JSON credential file of the service account. Required when `authentication_method` is `accountKey`, not allowed otherwise",
+										Optional:     true,
+										Sensitive:    true,
+										Type:         schema.TypeString,
+										ValidateFunc: validation.StringIsJSON,
+									},
+								},
+							},
+							MaxItems: 1,
+							Optional: true,
+							Type:     schema.TypeList,
+						},
+					},
+				},
+				MaxItems: 1,
+				Required: true,
+				Type:     schema.TypeList,
+			},
+		},
+	}
+}
+
+func getBlobstoreGoogleFromResourceData(resourceData *schema.ResourceData) api.GoogleBlobstore {
+	bucketConfigurationList := resourceData.Get("bucket_configuration").([]interface{})
+	bucketConfiguration := bucketConfigurationList[0].(map[string]interface{})
+	bucketList := bucketConfiguration["bucket"].([]interface{})
+	bucket := bucketList[0].(map[string]interface{})
+
+	bs := api.GoogleBlobstore{
+		Name: resourceData.Get("name").(string),
+		BucketConfiguration: api.GoogleBucketConfiguration{
+			Bucket: api.GoogleBucket{
+				Name:   bucket["name"].(string),
+				Prefix: bucket["prefix"].(string),
+				Region: bucket["region"].(string),
+			},
+			BucketSecurity: &api.GoogleBucketSecurity{
+				AuthenticationMethod: api.GoogleAuthenticationMethodApplicationDefault,
+			},
+		},
+	}
+
+	bucketSecurityList := bucketConfiguration["bucket_security"].([]interface{})
+	if len(bucketSecurityList) > 0 && bucketSecurityList[0] != nil {
+		bucketSecurity := bucketSecurityList[0].(map[string]interface{})
+
+		bs.BucketConfiguration.BucketSecurity = &api.GoogleBucketSecurity{
+			AuthenticationMethod: bucketSecurity["authentication_method"].(string),
+			AccountKey:           bucketSecurity["account_key"].(string),
+		}
+	}
+
+	if _, ok := resourceData.GetOk("soft_quota"); ok {
+		softQuotaList := resourceData.Get("soft_quota").([]interface{})
+		softQuotaConfig := softQuotaList[0].(map[string]interface{})
+
+		bs.SoftQuota = &blobstore.SoftQuota{
+			Limit: int64(softQuotaConfig["limit"].(int)),
+			Type:  softQuotaConfig["type"].(string),
+		}
+	}
+
+	return bs
+}
+
+// resourceBlobstoreGoogleCustomizeDiff validates the bucket security settings
+// as soon as they are known
+func resourceBlobstoreGoogleCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if len(diff.Get("bucket_configuration.0.bucket_security").([]interface{})) == 0 {
+		return nil
+	}
+	if !diff.NewValueKnown("bucket_configuration.0.bucket_security.0.authentication_method") || !diff.NewValueKnown("bucket_configuration.0.bucket_security.0.account_key") {
+		return nil
+	}
+
+	return validateGoogleAuthentication(
+		diff.Get("bucket_configuration.0.bucket_security.0.authentication_method").(string),
+		diff.Get("bucket_configuration.0.bucket_security.0.account_key").(string),
+	)
+}
+
+func newBlobstoreGoogleLogContext(ctx context.Context, name string) context.Context {
+	return tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_google", ResourceBlobstoreGoogle, map[string]interface{}{
+		"name": name,
+		"type": "google",
+	})
+}
+
+func resourceBlobstoreGoogleCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	bs := getBlobstoreGoogleFromResourceData(resourceData)
+
+	if err := client.CreateGoogleBlobstore(newBlobstoreGoogleLogContext(ctx, bs.Name), bs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(bs.Name)
+	resourceData.Set("name", bs.Name)
+
+	return resourceBlobstoreGoogleRead(ctx, resourceData, m)
+}
+
+func resourceBlobstoreGoogleRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+	client := api.NewClient(m)
+	ctx = newBlobstoreGoogleLogContext(ctx, resourceData.Id())
+
+	bs, err := client.GetGoogleBlobstore(ctx, resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if bs == nil {
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Blob store not found, removing it from state")
+		resourceData.SetId("")
+		return nil
+	}
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Read blob store", map[string]interface{}{
+		"bucket": bs.BucketConfiguration.Bucket.Name,
+		"region": bs.BucketConfiguration.Bucket.Region,
+	})
+
+	var genericBlobstoreInformation blobstore.Generic
+	genericBlobstores, err := nexusClient.BlobStore.List()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, generic := range genericBlobstores {
+		if generic.Name == bs.Name {
+			genericBlobstoreInformation = generic
+		}
+	}
+
+	if err := resourceData.Set("name", bs.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceData.Set("blob_count", genericBlobstoreInformation.BlobCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceData.Set("total_size_in_bytes", genericBlobstoreInformation.TotalSizeInBytes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceData.Set("bucket_configuration", flattenGoogleBucketConfiguration(&bs.BucketConfiguration, resourceData)); err != nil {
+		return diag.Errorf("error reading bucket configuration: %s", err)
+	}
+
+	if bs.SoftQuota != nil {
+		if err := resourceData.Set("soft_quota", flattenSoftQuota(bs.SoftQuota)); err != nil {
+			return diag.Errorf("error reading soft quota: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceBlobstoreGoogleUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	bs := getBlobstoreGoogleFromResourceData(resourceData)
+	if err := client.UpdateGoogleBlobstore(newBlobstoreGoogleLogContext(ctx, resourceData.Id()), resourceData.Id(), bs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBlobstoreGoogleRead(ctx, resourceData, m)
+}
+
+func resourceBlobstoreGoogleDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+
+	return deleteBlobstore(newBlobstoreGoogleLogContext(ctx, resourceData.Id()), resourceData, m, nexusClient.BlobStore.Delete)
+}
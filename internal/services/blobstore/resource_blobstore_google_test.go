@@ -0,0 +1,146 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceBlobstoreGoogle(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+	if tools.GetEnv("SKIP_GOOGLE_TESTS", "false") == "true" {
+		t.Skip("Skipping Google Cloud Storage tests")
+	}
+
+	resourceName := "nexus_blobstore_google.acceptance"
+
+	bs := testAccBlobstoreGoogle()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreGoogleConfig(bs),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", bs.Name),
+					resource.TestCheckResourceAttrSet(resourceName, "blob_count"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_size_in_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket.0.name", bs.BucketConfiguration.Bucket.Name),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket.0.region", bs.BucketConfiguration.Bucket.Region),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket.0.prefix", bs.BucketConfiguration.Bucket.Prefix),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket_security.0.authentication_method", bs.BucketConfiguration.BucketSecurity.AuthenticationMethod),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.0.limit", strconv.FormatInt(bs.SoftQuota.Limit, 10)),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.0.type", bs.SoftQuota.Type),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           bs.Name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bucket_configuration.0.bucket_security.0.account_key"},
+			},
+		},
+	})
+}
+
+func TestAccResourceBlobstoreGoogleAuthenticationValidation(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	bs := testAccBlobstoreGoogle()
+	bs.BucketConfiguration.BucketSecurity = &api.GoogleBucketSecurity{
+		AuthenticationMethod: api.GoogleAuthenticationMethodAccountKey,
+	}
+	applicationDefault := testAccBlobstoreGoogle()
+	applicationDefault.BucketConfiguration.BucketSecurity = &api.GoogleBucketSecurity{
+		AuthenticationMethod: api.GoogleAuthenticationMethodApplicationDefault,
+		AccountKey:           `{"type": "service_account"}`,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceBlobstoreGoogleConfig(bs),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("account_key is required"),
+			},
+			{
+				Config:      testAccResourceBlobstoreGoogleConfig(applicationDefault),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("account_key is not supported"),
+			},
+		},
+	})
+}
+
+func testAccBlobstoreGoogle() api.GoogleBlobstore {
+	bs := api.GoogleBlobstore{
+		Name: fmt.Sprintf("test-blobstore-google-%s", acctest.RandString(5)),
+		SoftQuota: &blobstore.SoftQuota{
+			Limit: 1024000000,
+			Type:  "spaceRemainingQuota",
+		},
+		BucketConfiguration: api.GoogleBucketConfiguration{
+			Bucket: api.GoogleBucket{
+				Name:   tools.GetEnv("GOOGLE_BUCKET_NAME", "terraform-provider-nexus-google-test"),
+				Region: tools.GetEnv("GOOGLE_REGION", "europe-west3"),
+				Prefix: acctest.RandString(5),
+			},
+			BucketSecurity: &api.GoogleBucketSecurity{
+				AuthenticationMethod: api.GoogleAuthenticationMethodApplicationDefault,
+			},
+		},
+	}
+
+	// Credentials are not required by the fake GCS server
+	if accountKey := tools.GetEnv("GOOGLE_ACCOUNT_KEY", ""); accountKey != "" {
+		bs.BucketConfiguration.BucketSecurity = &api.GoogleBucketSecurity{
+			AuthenticationMethod: api.GoogleAuthenticationMethodAccountKey,
+			AccountKey:           accountKey,
+		}
+	}
+
+	return bs
+}
+
+func testAccResourceBlobstoreGoogleConfig(bs api.GoogleBlobstore) string {
+	return fmt.Sprintf(`
+resource "nexus_blobstore_google" "acceptance" {
+	name = "%s"
+
+	bucket_configuration {
+		bucket {
+			name   = "%s"
+			region = "%s"
+			prefix = "%s"
+		}
+
+		bucket_security {
+			authentication_method = "%s"
+			account_key           = %q
+		}
+	}
+
+	soft_quota {
+		limit = %d
+		type  = "%s"
+	}
+}`, bs.Name, bs.BucketConfiguration.Bucket.Name, bs.BucketConfiguration.Bucket.Region, bs.BucketConfiguration.Bucket.Prefix,
+		bs.BucketConfiguration.BucketSecurity.AuthenticationMethod, bs.BucketConfiguration.BucketSecurity.AccountKey,
+		bs.SoftQuota.Limit, bs.SoftQuota.Type)
+}
@@ -1,32 +1,58 @@
 package blobstore
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"time"
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	// blobstoreGroupMemberRemovalTaskType is the type of the Nexus Pro task
+	// "Admin - Remove a member from a blob store group"
+	blobstoreGroupMemberRemovalTaskType = "blobstore.group.memberRemoval"
+)
+
+// blobstoreGroupMemberRemovalTaskPollInterval is the interval the state of the
+// task is polled in
+var blobstoreGroupMemberRemovalTaskPollInterval = 5 * time.Second
+
 func ResourceBlobstoreGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: `~> PRO Feature
 
-Use this resource to create a Nexus group blobstore.`,
+Use this resource to create a Nexus group blobstore.
+
+Nexus only accepts blobstores as new members which are not used by any repository. To move a blobstore which is in use
+into a group, promote it with ` + "`promoted_member`" + `.
 
-		Create: resourceBlobstoreGroupCreate,
-		Read:   resourceBlobstoreGroupRead,
-		Update: resourceBlobstoreGroupUpdate,
-		Delete: resourceBlobstoreGroupDelete,
-		Exists: resourceBlobstoreGroupExists,
+Members are added before any member is removed. Removed members which still contain blobs are drained with the Nexus
+task "Admin - Remove a member from a blob store group", which marks the member read-only, moves its blobs to the other
+members and removes it from the group. Draining can take long for large blobstores, see the ` + "`update`" + ` timeout.` + blobstoreDeleteDescription,
+
+		CreateContext: resourceBlobstoreGroupCreate,
+		ReadContext:   resourceBlobstoreGroupRead,
+		UpdateContext: resourceBlobstoreGroupUpdate,
+		DeleteContext: resourceBlobstoreGroupDelete,
+		Exists:        resourceBlobstoreGroupExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id":                       common.ResourceID,
@@ -48,6 +74,12 @@ Use this resource to create a Nexus group blobstore.`,
 				},
 				MinItems: 1,
 			},
+			"promoted_member": {
+				Description: "Create the group by promoting the existing blobstore with the same `name`. The existing blobstore is renamed to this value and becomes a member of the group, so repositories using it keep working. Must be one of `members`",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 			"soft_quota":          blobstoreSchema.ResourceSoftQuota,
 			"total_size_in_bytes": blobstoreSchema.ResourceTotalSizeInBytes,
 		},
@@ -73,37 +105,78 @@ func getBlobstoreGroupFromResourceData(resourceData *schema.ResourceData) blobst
 	return bs
 }
 
-func resourceBlobstoreGroupCreate(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreGroupCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_group", ResourceBlobstoreGroup, map[string]interface{}{
+		"name": resourceData.Get("name").(string),
+		"type": "group",
+	})
 
 	bs := getBlobstoreGroupFromResourceData(resourceData)
 
-	if err := nexusClient.BlobStore.Group.Create(&bs); err != nil {
-		return err
+	if promotedMember, ok := resourceData.GetOk("promoted_member"); ok {
+		if !tools.ContainsString(bs.Members, promotedMember.(string)) {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Promoted member missing",
+				Detail:        fmt.Sprintf("The promoted member %q must be one of the members of the group", promotedMember),
+				AttributePath: cty.GetAttrPath("members"),
+			}}
+		}
+
+		tflog.SubsystemInfo(ctx, tools.LogSubsystemBlobstore, "Promoting blob store to group", map[string]interface{}{
+			"promoted_member": promotedMember,
+		})
+		client := api.NewClient(m)
+		if err := client.ConvertBlobstoreToGroup(ctx, bs.Name, promotedMember.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		resourceData.SetId(bs.Name)
+
+		if diags := updateBlobstoreGroup(ctx, nexusClient, client, bs); diags.HasError() {
+			return append(diags, resourceBlobstoreGroupRead(ctx, resourceData, m)...)
+		}
+	} else {
+		if err := nexusClient.BlobStore.Group.Create(&bs); err != nil {
+			return diag.FromErr(err)
+		}
+		resourceData.SetId(bs.Name)
 	}
 
-	resourceData.SetId(bs.Name)
 	err := resourceData.Set("name", bs.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceBlobstoreGroupRead(resourceData, m)
+	return resourceBlobstoreGroupRead(ctx, resourceData, m)
 }
 
-func resourceBlobstoreGroupRead(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreGroupRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_group", ResourceBlobstoreGroup, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "group",
+	})
 
 	bs, err := nexusClient.BlobStore.Group.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
+	if bs == nil {
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Blob store not found, removing it from state")
+		resourceData.SetId("")
+		return nil
+	}
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Read blob store", map[string]interface{}{
+		"fill_policy": bs.FillPolicy,
+		"members":     bs.Members,
+	})
+
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -111,62 +184,147 @@ func resourceBlobstoreGroupRead(resourceData *schema.ResourceData, m interface{}
 		}
 	}
 
-	if bs == nil {
-		resourceData.SetId("")
-		return nil
-	}
-
 	if err := resourceData.Set("available_space_in_bytes", genericBlobstoreInformation.AvailableSpaceInBytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("blob_count", genericBlobstoreInformation.BlobCount); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("fill_policy", string(bs.FillPolicy)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("members", bs.Members); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("name", bs.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("total_size_in_bytes", genericBlobstoreInformation.TotalSizeInBytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bs.SoftQuota != nil {
 		if err := resourceData.Set("soft_quota", flattenSoftQuota(bs.SoftQuota)); err != nil {
-			return fmt.Errorf("error reading soft quota: %s", err)
+			return diag.Errorf("error reading soft quota: %s", err)
 		}
 	}
 
 	return nil
 }
 
-func resourceBlobstoreGroupUpdate(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreGroupUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_group", ResourceBlobstoreGroup, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "group",
+	})
 
 	bs := getBlobstoreGroupFromResourceData(resourceData)
-	if err := nexusClient.BlobStore.Group.Update(resourceData.Id(), &bs); err != nil {
-		return err
+	if diags := updateBlobstoreGroup(ctx, nexusClient, api.NewClient(m), bs); diags.HasError() {
+		// Keep the members Nexus actually has in the state
+		return append(diags, resourceBlobstoreGroupRead(ctx, resourceData, m)...)
 	}
 
 	return nil
 }
 
-func resourceBlobstoreGroupDelete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+// updateBlobstoreGroup updates the group in the order Nexus accepts: new
+// members are added first, members are removed afterwards. Members which still
+// contain blobs are drained before.
+func updateBlobstoreGroup(ctx context.Context, nexusClient *nexus.NexusClient, client *api.Client, bs blobstore.Group) diag.Diagnostics {
+	current, err := nexusClient.BlobStore.Group.Get(bs.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == nil {
+		return diag.Errorf("blobstore group %s not found", bs.Name)
+	}
 
-	if err := nexusClient.BlobStore.Group.Delete(resourceData.Id()); err != nil {
-		return err
+	var removedMembers []string
+	for _, member := range current.Members {
+		if !tools.ContainsString(bs.Members, member) {
+			removedMembers = append(removedMembers, member)
+		}
 	}
 
-	resourceData.SetId("")
+	if len(removedMembers) > 0 {
+		intermediate := bs
+		intermediate.Members = append(append([]string{}, bs.Members...), removedMembers...)
+
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Adding blob store group members", map[string]interface{}{
+			"members": intermediate.Members,
+		})
+		if err := nexusClient.BlobStore.Group.Update(bs.Name, &intermediate); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if diags := drainBlobstoreGroupMembers(ctx, nexusClient, client, bs.Name, removedMembers); diags.HasError() {
+			return diags
+		}
+	}
+
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Updating blob store group", map[string]interface{}{
+		"members":         bs.Members,
+		"removed_members": removedMembers,
+	})
+	if err := nexusClient.BlobStore.Group.Update(bs.Name, &bs); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
 
+// drainBlobstoreGroupMembers runs the Nexus task "Admin - Remove a member from
+// a blob store group" for each of the members which still contains blobs. The
+// task marks the member read-only, moves its blobs to the other members and
+// removes it from the group. Empty members are left to the group update.
+func drainBlobstoreGroupMembers(ctx context.Context, nexusClient *nexus.NexusClient, client *api.Client, name string, members []string) diag.Diagnostics {
+	blobstores, err := nexusClient.BlobStore.List()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, bs := range blobstores {
+		if !tools.ContainsString(members, bs.Name) || bs.BlobCount == 0 {
+			continue
+		}
+
+		tflog.SubsystemInfo(ctx, tools.LogSubsystemBlobstore, "Draining blob store group member", map[string]interface{}{
+			"member":     bs.Name,
+			"blob_count": bs.BlobCount,
+		})
+		if err := client.RunTemporaryTask(ctx, api.TaskTemplate{
+			Type:                  blobstoreGroupMemberRemovalTaskType,
+			Name:                  fmt.Sprintf("Terraform: remove member %s from blob store group %s", bs.Name, name),
+			Enabled:               true,
+			NotificationCondition: "FAILURE",
+			Frequency:             api.TaskFrequency{Schedule: api.TaskScheduleManual},
+			Properties: map[string]string{
+				"blobstoreName": name,
+				"memberName":    bs.Name,
+			},
+		}, blobstoreGroupMemberRemovalTaskPollInterval); err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Could not drain member %q of blobstore group %q", bs.Name, name),
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("members"),
+			}}
+		}
+	}
+	return nil
+}
+
+func resourceBlobstoreGroupDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_group", ResourceBlobstoreGroup, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "group",
+	})
+
+	return deleteBlobstore(ctx, resourceData, m, nexusClient.BlobStore.Group.Delete)
+}
+
 func resourceBlobstoreGroupExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	nexusClient := m.(*nexus.NexusClient)
 
@@ -3,10 +3,13 @@ package blobstore_test
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"strconv"
 	"testing"
 	"text/template"
 
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
@@ -100,3 +103,50 @@ func TestAccResourceBlobstoreGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceBlobstoreGroupPromote(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resourceName := "nexus_blobstore_group.acceptance"
+	name := fmt.Sprintf("test-blobstore-%s", acctest.RandString(5))
+	promotedMember := fmt.Sprintf("%s-promoted", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.AccPreCheck(t)
+
+			// The blob store to promote must exist outside of terraform
+			nexusClient := nexus.NewClient(client.Config{
+				URL:      os.Getenv("NEXUS_URL"),
+				Username: os.Getenv("NEXUS_USERNAME"),
+				Password: os.Getenv("NEXUS_PASSWORD"),
+			})
+			if err := nexusClient.BlobStore.File.Create(&blobstore.File{Name: name, Path: name}); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				_ = nexusClient.BlobStore.Delete(promotedMember)
+			})
+		},
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "nexus_blobstore_group" "acceptance" {
+	name            = "%s"
+	fill_policy     = "writeToFirst"
+	members         = ["%s"]
+	promoted_member = "%s"
+}`, name, promotedMember, promotedMember),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "fill_policy", blobstore.GroupFillPolicyWriteToFirst),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "members.0", promotedMember),
+				),
+			},
+		},
+	})
+}
@@ -1,30 +1,38 @@
 package blobstore
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
-	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	s3EncryptionTypeNone       = "none"
+	s3EncryptionTypeS3Managed  = "s3ManagedEncryption"
+	s3EncryptionTypeKMSManaged = "kmsManagedEncryption"
+)
+
 func ResourceBlobstoreS3() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create a Nexus S3 blobstore.",
+		Description: "Use this resource to create a Nexus S3 blobstore." + blobstoreDeleteDescription,
 
-		Create: resourceBlobstoreS3Create,
-		Read:   resourceBlobstoreS3Read,
-		Update: resourceBlobstoreS3Update,
-		Delete: resourceBlobstoreS3Delete,
-		Exists: resourceBlobstoreS3Exists,
+		CreateContext: resourceBlobstoreS3Create,
+		ReadContext:   resourceBlobstoreS3Read,
+		UpdateContext: resourceBlobstoreS3Update,
+		DeleteContext: resourceBlobstoreS3Delete,
+		Exists:        resourceBlobstoreS3Exists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceBlobstoreS3CustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id":                  common.ResourceID,
@@ -32,6 +40,12 @@ func ResourceBlobstoreS3() *schema.Resource {
 			"blob_count":          blobstoreSchema.ResourceBlobCount,
 			"soft_quota":          blobstoreSchema.ResourceSoftQuota,
 			"total_size_in_bytes": blobstoreSchema.ResourceTotalSizeInBytes,
+			"verify_bucket": {
+				Default:     false,
+				Description: "Check that the bucket exists and is reachable through the configured endpoint with the configured credentials before Nexus is asked to create or update the blobstore. The check runs on the Terraform host, not on Nexus, during plan if the bucket configuration is known and again during apply. Its network access and credentials can therefore differ from the ones of Nexus. Without `access_key_id` only missing buckets are detected",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			"bucket_configuration": {
 				Description: "The S3 bucket configuration.",
 				Elem: &schema.Resource{
@@ -87,9 +101,10 @@ func ResourceBlobstoreS3() *schema.Resource {
 										Type:        schema.TypeString,
 									},
 									"expiration": {
-										Description: "How many days until deleted blobs are finally removed from the S3 bucket (-1 to disable)",
-										Required:    true,
-										Type:        schema.TypeInt,
+										Description:  "How many days until deleted blobs are finally removed from the S3 bucket (-1 to disable). Nexus maintains a lifecycle rule on the bucket for this",
+										Required:     true,
+										Type:         schema.TypeInt,
+										ValidateFunc: validation.IntAtLeast(-1),
 									},
 								},
 							},
@@ -134,15 +149,15 @@ func ResourceBlobstoreS3() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"encryption_key": {
-										Description: "The encryption key.",
+										Description: "The ID or ARN of the KMS key. Required if `encryption_type` is `kmsManagedEncryption`, not allowed otherwise",
 										Optional:    true,
 										Type:        schema.TypeString,
 									},
 									"encryption_type": {
-										Description:  "The type of S3 server side encryption to use.",
+										Description:  "The type of S3 server side encryption to use. Possible values: `none`, `s3ManagedEncryption` or `kmsManagedEncryption`",
 										Optional:     true,
 										Type:         schema.TypeString,
-										ValidateFunc: validation.StringInSlice([]string{"s3ManagedEncryption", "kmsManagedEncryption"}, false),
+										ValidateFunc: validation.StringInSlice([]string{s3EncryptionTypeNone, s3EncryptionTypeS3Managed, s3EncryptionTypeKMSManaged}, false),
 									},
 								},
 							},
@@ -161,20 +176,37 @@ func ResourceBlobstoreS3() *schema.Resource {
 }
 
 func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
-	bucketConfigurationList := d.Get("bucket_configuration").([]interface{})
+	bs := blobstore.S3{
+		Name:                d.Get("name").(string),
+		BucketConfiguration: getS3BucketConfiguration(d.Get("bucket_configuration").([]interface{})),
+	}
+
+	if _, ok := d.GetOk("soft_quota"); ok {
+		softQuotaList := d.Get("soft_quota").([]interface{})
+		softQuotaConfig := softQuotaList[0].(map[string]interface{})
+
+		bs.SoftQuota = &blobstore.SoftQuota{
+			Limit: int64(softQuotaConfig["limit"].(int)),
+			Type:  softQuotaConfig["type"].(string),
+		}
+	}
+
+	return bs
+}
+
+// getS3BucketConfiguration converts the bucket_configuration attribute, as it
+// is read during plan and apply
+func getS3BucketConfiguration(bucketConfigurationList []interface{}) blobstore.S3BucketConfiguration {
 	bucketConfiguration := bucketConfigurationList[0].(map[string]interface{})
 	bucketList := bucketConfiguration["bucket"].([]interface{})
 	bucket := bucketList[0].(map[string]interface{})
 
-	bs := blobstore.S3{
-		Name: d.Get("name").(string),
-		BucketConfiguration: blobstore.S3BucketConfiguration{
-			Bucket: blobstore.S3Bucket{
-				Expiration: int32(bucket["expiration"].(int)),
-				Name:       bucket["name"].(string),
-				Prefix:     bucket["prefix"].(string),
-				Region:     bucket["region"].(string),
-			},
+	result := blobstore.S3BucketConfiguration{
+		Bucket: blobstore.S3Bucket{
+			Expiration: int32(bucket["expiration"].(int)),
+			Name:       bucket["name"].(string),
+			Prefix:     bucket["prefix"].(string),
+			Region:     bucket["region"].(string),
 		},
 	}
 
@@ -183,7 +215,7 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 		if len(advancedBucketConfigurationList) > 0 {
 			advancedBucketConfiguration := advancedBucketConfigurationList[0].(map[string]interface{})
 
-			bs.BucketConfiguration.AdvancedBucketConnection = &blobstore.S3AdvancedBucketConnection{
+			result.AdvancedBucketConnection = &blobstore.S3AdvancedBucketConnection{
 				Endpoint:       advancedBucketConfiguration["endpoint"].(string),
 				SignerType:     advancedBucketConfiguration["signer_type"].(string),
 				ForcePathStyle: tools.GetBoolPointer(advancedBucketConfiguration["force_path_style"].(bool)),
@@ -196,7 +228,7 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 		if len(bucketSecurityList) > 0 && bucketSecurityList[0] != nil {
 			bucketSecurity := bucketSecurityList[0].(map[string]interface{})
 
-			bs.BucketConfiguration.BucketSecurity = &blobstore.S3BucketSecurity{
+			result.BucketSecurity = &blobstore.S3BucketSecurity{
 				AccessKeyID:     bucketSecurity["access_key_id"].(string),
 				Role:            bucketSecurity["role"].(string),
 				SecretAccessKey: bucketSecurity["secret_access_key"].(string),
@@ -207,57 +239,72 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 
 	if _, ok := bucketConfiguration["encryption"]; ok {
 		encryptionList := bucketConfiguration["encryption"].([]interface{})
-		if len(encryptionList) > 0 {
+		// Nexus does not know the encryption type none, it omits the encryption
+		if len(encryptionList) > 0 && encryptionList[0] != nil && encryptionList[0].(map[string]interface{})["encryption_type"] != s3EncryptionTypeNone {
 			encryption := encryptionList[0].(map[string]interface{})
 
-			bs.BucketConfiguration.Encryption = &blobstore.S3Encryption{
+			result.Encryption = &blobstore.S3Encryption{
 				Key:  encryption["encryption_key"].(string),
 				Type: encryption["encryption_type"].(string),
 			}
 		}
 	}
 
-	if _, ok := d.GetOk("soft_quota"); ok {
-		softQuotaList := d.Get("soft_quota").([]interface{})
-		softQuotaConfig := softQuotaList[0].(map[string]interface{})
-
-		bs.SoftQuota = &blobstore.SoftQuota{
-			Limit: int64(softQuotaConfig["limit"].(int)),
-			Type:  softQuotaConfig["type"].(string),
-		}
-	}
-
-	return bs
+	return result
 }
 
-func resourceBlobstoreS3Create(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreS3Create(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
 
 	bs := getBlobstoreS3FromResourceData(resourceData)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_s3", ResourceBlobstoreS3, map[string]interface{}{
+		"name": bs.Name,
+		"type": "s3",
+	})
+
+	if resourceData.Get("verify_bucket").(bool) {
+		if err := checkS3Bucket(ctx, bs.BucketConfiguration); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Creating blob store")
 	if err := nexusClient.BlobStore.S3.Create(&bs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(bs.Name)
 	resourceData.Set("name", bs.Name)
 
-	return resourceBlobstoreS3Read(resourceData, m)
+	return resourceBlobstoreS3Read(ctx, resourceData, m)
 }
 
-func resourceBlobstoreS3Read(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreS3Read(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_s3", ResourceBlobstoreS3, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "s3",
+	})
 
 	bs, err := nexusClient.BlobStore.S3.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	if bs == nil {
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Blob store not found, removing it from state")
+		resourceData.SetId("")
+		return nil
 	}
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Read blob store", map[string]interface{}{
+		"bucket": bs.BucketConfiguration.Bucket.Name,
+		"region": bs.BucketConfiguration.Bucket.Region,
+	})
 
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -265,54 +312,89 @@ func resourceBlobstoreS3Read(resourceData *schema.ResourceData, m interface{}) e
 		}
 	}
 
-	if bs == nil {
-		resourceData.SetId("")
-		return nil
-	}
-
 	if err := resourceData.Set("name", bs.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("blob_count", genericBlobstoreInformation.BlobCount); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("total_size_in_bytes", genericBlobstoreInformation.TotalSizeInBytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := resourceData.Set("bucket_configuration", flattenS3BucketConfiguration(&bs.BucketConfiguration, resourceData)); err != nil {
-		return fmt.Errorf("error reading bucket configuration: %s", err)
+		return diag.Errorf("error reading bucket configuration: %s", err)
 	}
 
 	if bs.SoftQuota != nil {
 		if err := resourceData.Set("soft_quota", flattenSoftQuota(bs.SoftQuota)); err != nil {
-			return fmt.Errorf("error reading soft quota: %s", err)
+			return diag.Errorf("error reading soft quota: %s", err)
 		}
 	}
 
 	return nil
 }
 
-func resourceBlobstoreS3Update(resourceData *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreS3Update(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_s3", ResourceBlobstoreS3, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "s3",
+	})
 
 	bs := getBlobstoreS3FromResourceData(resourceData)
+
+	if resourceData.Get("verify_bucket").(bool) && resourceData.HasChange("bucket_configuration") {
+		if err := checkS3Bucket(ctx, bs.BucketConfiguration); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Updating blob store")
 	if err := nexusClient.BlobStore.S3.Update(resourceData.Id(), &bs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceBlobstoreS3Delete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+// resourceBlobstoreS3CustomizeDiff validates the encryption settings and, if
+// enabled, checks the bucket as soon as its configuration is known
+func resourceBlobstoreS3CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.NewValueKnown("bucket_configuration.0.encryption.0.encryption_type") && diff.NewValueKnown("bucket_configuration.0.encryption.0.encryption_key") {
+		if _, ok := diff.GetOk("bucket_configuration.0.encryption.0"); ok {
+			if err := validateS3Encryption(
+				diff.Get("bucket_configuration.0.encryption.0.encryption_type").(string),
+				diff.Get("bucket_configuration.0.encryption.0.encryption_key").(string),
+			); err != nil {
+				return err
+			}
+		}
+	}
 
-	if err := nexusClient.BlobStore.S3.Delete(resourceData.Id()); err != nil {
-		return err
+	if !diff.Get("verify_bucket").(bool) || (diff.Id() != "" && !diff.HasChange("bucket_configuration")) {
+		return nil
 	}
 
-	resourceData.SetId("")
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_s3", ResourceBlobstoreS3, map[string]interface{}{
+		"name": diff.Get("name"),
+		"type": "s3",
+	})
+	if !diff.NewValueKnown("bucket_configuration") {
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Bucket check postponed to apply, the bucket configuration is not known yet")
+		return nil
+	}
 
-	return nil
+	return checkS3Bucket(ctx, getS3BucketConfiguration(diff.Get("bucket_configuration").([]interface{})))
+}
+
+func resourceBlobstoreS3Delete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nexusClient := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore_s3", ResourceBlobstoreS3, map[string]interface{}{
+		"name": resourceData.Id(),
+		"type": "s3",
+	})
+
+	return deleteBlobstore(ctx, resourceData, m, nexusClient.BlobStore.S3.Delete)
 }
 
 func resourceBlobstoreS3Exists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
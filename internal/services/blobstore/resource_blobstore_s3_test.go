@@ -2,6 +2,7 @@ package blobstore_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -61,7 +62,7 @@ func TestAccResourceBlobstoreS3(t *testing.T) {
 				ImportState:             true,
 				ImportStateId:           bs.Name,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"bucket_configuration.0.bucket_security.0.secret_access_key"},
+				ImportStateVerifyIgnore: []string{"bucket_configuration.0.bucket_security.0.secret_access_key", "verify_bucket"},
 			},
 		},
 	})
@@ -91,3 +92,67 @@ resource "nexus_blobstore_s3" "acceptance" {
 	}
 }`, bs.Name, bs.BucketConfiguration.Bucket.Name, bs.BucketConfiguration.Bucket.Region, bs.BucketConfiguration.Bucket.Expiration, awsAccessKeyID, awsSecretAccessKey, bs.BucketConfiguration.AdvancedBucketConnection.Endpoint, strconv.FormatBool(*bs.BucketConfiguration.AdvancedBucketConnection.ForcePathStyle))
 }
+
+func TestAccResourceBlobstoreS3Validation(t *testing.T) {
+	if tools.GetEnv("SKIP_S3_TESTS", "false") == "true" {
+		t.Skip("Skipping S3 tests")
+	}
+
+	name := fmt.Sprintf("test-blobstore-s3-%s", acctest.RandString(5))
+	awsAccessKeyID := tools.GetEnv("AWS_ACCESS_KEY_ID", "")
+	awsSecretAccessKey := tools.GetEnv("AWS_SECRET_ACCESS_KEY", "")
+	endpoint := tools.GetEnv("AWS_ENDPOINT", "")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceBlobstoreS3ValidationConfig(name, "missing-bucket-"+acctest.RandString(5), endpoint, awsAccessKeyID, awsSecretAccessKey, `encryption_type = "s3ManagedEncryption"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("does not exist"),
+			},
+			{
+				Config:      testAccResourceBlobstoreS3ValidationConfig(name, "missing-bucket", endpoint, awsAccessKeyID, awsSecretAccessKey, `encryption_type = "kmsManagedEncryption"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("encryption_key is required"),
+			},
+			{
+				Config: testAccResourceBlobstoreS3ValidationConfig(name, "missing-bucket", endpoint, awsAccessKeyID, awsSecretAccessKey, `encryption_type = "s3ManagedEncryption"
+			encryption_key  = "arn:aws:kms:eu-central-1:123456789012:key/nexus"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("encryption_key is only supported"),
+			},
+		},
+	})
+}
+
+func testAccResourceBlobstoreS3ValidationConfig(name string, bucket string, endpoint string, awsAccessKeyID string, awsSecretAccessKey string, encryption string) string {
+	return fmt.Sprintf(`
+resource "nexus_blobstore_s3" "acceptance" {
+	name          = "%s"
+	verify_bucket = true
+
+	bucket_configuration {
+		bucket {
+			name       = "%s"
+			region     = "eu-central-1"
+			expiration = -1
+		}
+
+		bucket_security {
+			access_key_id     = "%s"
+			secret_access_key = "%s"
+		}
+
+		advanced_bucket_connection {
+			endpoint         = "%s"
+			force_path_style = true
+		}
+
+		encryption {
+			%s
+		}
+	}
+}`, name, bucket, awsAccessKeyID, awsSecretAccessKey, endpoint, encryption)
+}
@@ -0,0 +1,65 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+)
+
+// checkS3Bucket verifies that the bucket of the blob store exists and is
+// reachable by sending a HeadBucket request through the configured endpoint
+// with the configured credentials. The request is sent from the Terraform
+// host, not from Nexus. Without an access key it is sent anonymously and only
+// missing buckets are reported.
+func checkS3Bucket(ctx context.Context, config blobstore.S3BucketConfiguration) error {
+	bucket := config.Bucket
+	options := s3.Options{
+		Region:      bucket.Region,
+		Credentials: aws.AnonymousCredentials{},
+	}
+
+	if connection := config.AdvancedBucketConnection; connection != nil {
+		if connection.Endpoint != "" {
+			options.EndpointResolver = s3.EndpointResolverFromURL(connection.Endpoint)
+		}
+		if connection.ForcePathStyle != nil {
+			options.UsePathStyle = *connection.ForcePathStyle
+		}
+	}
+
+	anonymous := true
+	if security := config.BucketSecurity; security != nil && security.AccessKeyID != "" {
+		options.Credentials = credentials.NewStaticCredentialsProvider(security.AccessKeyID, security.SecretAccessKey, security.SessionToken)
+		anonymous = false
+	}
+
+	_, err := s3.New(options).HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket.Name),
+	})
+	if err == nil {
+		return nil
+	}
+
+	var responseErr *smithyhttp.ResponseError
+	if errors.As(err, &responseErr) {
+		switch responseErr.HTTPStatusCode() {
+		case http.StatusNotFound:
+			return fmt.Errorf("S3 bucket '%s' does not exist", bucket.Name)
+		case http.StatusMovedPermanently:
+			return fmt.Errorf("S3 bucket '%s' is not located in region '%s'", bucket.Name, bucket.Region)
+		case http.StatusForbidden:
+			if anonymous {
+				return nil
+			}
+			return fmt.Errorf("access to S3 bucket '%s' denied for access key ID '%s'", bucket.Name, config.BucketSecurity.AccessKeyID)
+		}
+	}
+	return fmt.Errorf("could not check S3 bucket '%s': %v", bucket.Name, err)
+}
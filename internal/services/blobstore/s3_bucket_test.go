@@ -0,0 +1,56 @@
+package blobstore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func testS3BucketConfiguration(endpoint string, accessKeyID string) blobstore.S3BucketConfiguration {
+	forcePathStyle := true
+	config := blobstore.S3BucketConfiguration{
+		Bucket: blobstore.S3Bucket{
+			Name:   "nexus",
+			Region: "eu-central-1",
+		},
+		AdvancedBucketConnection: &blobstore.S3AdvancedBucketConnection{
+			Endpoint:       endpoint,
+			ForcePathStyle: &forcePathStyle,
+		},
+	}
+	if accessKeyID != "" {
+		config.BucketSecurity = &blobstore.S3BucketSecurity{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: "secret",
+		}
+	}
+	return config
+}
+
+func TestCheckS3Bucket(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		assert.Equal(t, "/nexus", r.URL.Path)
+		if strings.Contains(r.Header.Get("Authorization"), "Credential=minio/") {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	assert.NoError(t, checkS3Bucket(context.Background(), testS3BucketConfiguration(server.URL, "minio")))
+	assert.ErrorContains(t, checkS3Bucket(context.Background(), testS3BucketConfiguration(server.URL, "other")), "access to S3 bucket 'nexus' denied for access key ID 'other'")
+
+	// Anonymous requests can only detect missing buckets
+	assert.NoError(t, checkS3Bucket(context.Background(), testS3BucketConfiguration(server.URL, "")))
+
+	status = http.StatusNotFound
+	assert.ErrorContains(t, checkS3Bucket(context.Background(), testS3BucketConfiguration(server.URL, "minio")), "S3 bucket 'nexus' does not exist")
+}
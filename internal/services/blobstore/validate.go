@@ -0,0 +1,50 @@
+package blobstore
+
+import (
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+)
+
+// validateS3Encryption checks that a key is given for KMS managed encryption
+// only
+func validateS3Encryption(encryptionType string, encryptionKey string) error {
+	if encryptionType == s3EncryptionTypeKMSManaged && encryptionKey == "" {
+		return fmt.Errorf("bucket_configuration.0.encryption.0.encryption_key is required for encryption_type %q", s3EncryptionTypeKMSManaged)
+	}
+	if encryptionType != s3EncryptionTypeKMSManaged && encryptionKey != "" {
+		return fmt.Errorf("bucket_configuration.0.encryption.0.encryption_key is only supported for encryption_type %q", s3EncryptionTypeKMSManaged)
+	}
+	return nil
+}
+
+// validateAzureAuthentication checks that the fields required by the
+// authentication method are set and no others
+func validateAzureAuthentication(authenticationMethod blobstore.AzureAuthenticationMethod, accountKey string) error {
+	switch authenticationMethod {
+	case blobstore.AzureAuthenticationMethodAccountKey:
+		if accountKey == "" {
+			return fmt.Errorf("bucket_configuration.0.authentication.0.account_key is required for authentication_method %q", authenticationMethod)
+		}
+	case blobstore.AzureAuthenticationMethodManagedIdentity, azureAuthenticationMethodEnvironmentVariable:
+		if accountKey != "" {
+			return fmt.Errorf("bucket_configuration.0.authentication.0.account_key is not supported for authentication_method %q", authenticationMethod)
+		}
+	default:
+		return fmt.Errorf("unsupported authentication_method %q", authenticationMethod)
+	}
+	return nil
+}
+
+// validateGoogleAuthentication checks that an account key is given for the
+// account key authentication only
+func validateGoogleAuthentication(authenticationMethod string, accountKey string) error {
+	if authenticationMethod == api.GoogleAuthenticationMethodAccountKey && accountKey == "" {
+		return fmt.Errorf("bucket_configuration.0.bucket_security.0.account_key is required for authentication_method %q", authenticationMethod)
+	}
+	if authenticationMethod != api.GoogleAuthenticationMethodAccountKey && accountKey != "" {
+		return fmt.Errorf("bucket_configuration.0.bucket_security.0.account_key is not supported for authentication_method %q", authenticationMethod)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package blobstore
+
+import (
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateS3Encryption(t *testing.T) {
+	assert.NoError(t, validateS3Encryption("", ""))
+	assert.NoError(t, validateS3Encryption(s3EncryptionTypeNone, ""))
+	assert.NoError(t, validateS3Encryption(s3EncryptionTypeS3Managed, ""))
+	assert.NoError(t, validateS3Encryption(s3EncryptionTypeKMSManaged, "arn:aws:kms:eu-central-1:123456789012:key/nexus"))
+
+	assert.ErrorContains(t, validateS3Encryption(s3EncryptionTypeKMSManaged, ""), "is required")
+	assert.ErrorContains(t, validateS3Encryption(s3EncryptionTypeS3Managed, "key"), "is only supported")
+}
+
+func TestValidateAzureAuthentication(t *testing.T) {
+	assert.NoError(t, validateAzureAuthentication(blobstore.AzureAuthenticationMethodAccountKey, "key"))
+	assert.NoError(t, validateAzureAuthentication(blobstore.AzureAuthenticationMethodManagedIdentity, ""))
+	assert.NoError(t, validateAzureAuthentication(azureAuthenticationMethodEnvironmentVariable, ""))
+
+	assert.ErrorContains(t, validateAzureAuthentication(blobstore.AzureAuthenticationMethodAccountKey, ""), "is required")
+	assert.ErrorContains(t, validateAzureAuthentication(blobstore.AzureAuthenticationMethodManagedIdentity, "key"), "is not supported")
+	assert.ErrorContains(t, validateAzureAuthentication(azureAuthenticationMethodEnvironmentVariable, "key"), "is not supported")
+	assert.ErrorContains(t, validateAzureAuthentication("SAS", ""), "unsupported")
+}
+
+func TestBlobstoreFilePathsOverlap(t *testing.T) {
+	assert.True(t, blobstoreFilePathsOverlap("/nexus-data/blobs/default", "/nexus-data/blobs/default"))
+	assert.True(t, blobstoreFilePathsOverlap("/nexus-data/blobs", "/nexus-data/blobs/default"))
+	assert.True(t, blobstoreFilePathsOverlap("/nexus-data/blobs/default/nested", "/nexus-data/blobs/default"))
+
+	assert.False(t, blobstoreFilePathsOverlap("/nexus-data/blobs/default", "/nexus-data/blobs/default-2"))
+	assert.False(t, blobstoreFilePathsOverlap("/nexus-data/acceptance", "/nexus-data/acceptance-in-use"))
+}
+
+func TestSuppressEquivalentBlobstoreFilePath(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBlobstoreFile().Schema, map[string]interface{}{})
+	assert.NoError(t, resourceData.Set("absolute_path", "/nexus-data/blobs/default"))
+
+	assert.Equal(t, "/nexus-data/blobs/default", resolveBlobstoreFilePath("/nexus-data/blobs", "default"))
+	assert.Equal(t, "/nexus-data/blobs/default", resolveBlobstoreFilePath("/nexus-data/blobs", "/nexus-data/blobs/default/"))
+
+	assert.True(t, suppressEquivalentBlobstoreFilePath("path", "default", "/nexus-data/blobs/default", resourceData))
+	assert.True(t, suppressEquivalentBlobstoreFilePath("path", "/nexus-data/blobs/default", "default", resourceData))
+	assert.True(t, suppressEquivalentBlobstoreFilePath("path", "default", "./default/", resourceData))
+
+	assert.False(t, suppressEquivalentBlobstoreFilePath("path", "default", "other", resourceData))
+	assert.False(t, suppressEquivalentBlobstoreFilePath("path", "default", "/nexus-data/other", resourceData))
+	assert.False(t, suppressEquivalentBlobstoreFilePath("path", "/nexus-data/blobs/default", "other", resourceData))
+}
+
+func TestValidateGoogleAuthentication(t *testing.T) {
+	assert.NoError(t, validateGoogleAuthentication(api.GoogleAuthenticationMethodAccountKey, `{"type": "service_account"}`))
+	assert.NoError(t, validateGoogleAuthentication(api.GoogleAuthenticationMethodApplicationDefault, ""))
+
+	assert.ErrorContains(t, validateGoogleAuthentication(api.GoogleAuthenticationMethodAccountKey, ""), "is required")
+	assert.ErrorContains(t, validateGoogleAuthentication(api.GoogleAuthenticationMethodApplicationDefault, "{}"), "is not supported")
+}
@@ -1,7 +1,10 @@
 package deprecated
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,7 +16,7 @@ func DataSourceBlobstore() *schema.Resource {
 
 Use this to get informations about a Nexus blobstore.`,
 
-		Read: dataSourceBlobstoreRead,
+		ReadContext: dataSourceBlobstoreRead,
 		Schema: map[string]*schema.Schema{
 			"id": common.DataSourceID,
 			"type": {
@@ -191,8 +194,8 @@ Use this to get informations about a Nexus blobstore.`,
 	}
 }
 
-func dataSourceBlobstoreRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceBlobstoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	d.SetId(d.Get("name").(string))
 
-	return resourceBlobstoreRead(d, m)
+	return resourceBlobstoreRead(ctx, d, m)
 }
@@ -1,14 +1,15 @@
 package deprecated
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strconv"
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
-	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -20,11 +21,11 @@ func ResourceBlobstore() *schema.Resource {
 
 Use this resource to create a Nexus blobstore.`,
 
-		Create: resourceBlobstoreCreate,
-		Read:   resourceBlobstoreRead,
-		Update: resourceBlobstoreUpdate,
-		Delete: resourceBlobstoreDelete,
-		Exists: resourceBlobstoreExists,
+		CreateContext: resourceBlobstoreCreate,
+		ReadContext:   resourceBlobstoreRead,
+		Update:        resourceBlobstoreUpdate,
+		Delete:        resourceBlobstoreDelete,
+		Exists:        resourceBlobstoreExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -294,34 +295,41 @@ func getBlobstoreFromResourceData(d *schema.ResourceData) blobstore.Legacy {
 	return bs
 }
 
-func resourceBlobstoreCreate(d *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*nexus.NexusClient)
 
 	bs := getBlobstoreFromResourceData(d)
 
 	if err := client.BlobStore.Legacy.Create(&bs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(bs.Name)
 	d.Set("name", bs.Name)
 
-	return resourceBlobstoreRead(d, m)
+	return resourceBlobstoreRead(ctx, d, m)
 }
 
-func resourceBlobstoreRead(d *schema.ResourceData, m interface{}) error {
+func resourceBlobstoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*nexus.NexusClient)
+	ctx = tools.NewResourceLogContext(ctx, tools.LogSubsystemBlobstore, "nexus_blobstore", ResourceBlobstore, map[string]interface{}{
+		"name": d.Id(),
+	})
 
 	bs, err := client.BlobStore.Legacy.Get(d.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bs == nil {
+		tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Blob store not found, removing it from state")
 		d.SetId("")
 		return nil
 	}
+	tflog.SubsystemDebug(ctx, tools.LogSubsystemBlobstore, "Read blob store", map[string]interface{}{
+		"path": bs.Path,
+		"type": bs.Type,
+	})
 
 	d.Set("available_space_in_bytes", strconv.Itoa(bs.AvailableSpaceInBytes))
 	d.Set("blob_count", bs.BlobCount)
@@ -332,13 +340,13 @@ func resourceBlobstoreRead(d *schema.ResourceData, m interface{}) error {
 
 	if bs.S3BucketConfiguration != nil {
 		if err := d.Set("bucket_configuration", flattenBlobstoreBucketConfiguration(bs.S3BucketConfiguration, d)); err != nil {
-			return fmt.Errorf("error reading bucket configuration: %s", err)
+			return diag.Errorf("error reading bucket configuration: %s", err)
 		}
 	}
 
 	if bs.SoftQuota != nil {
 		if err := d.Set("soft_quota", flattenBlobstoreSoftQuota(bs.SoftQuota)); err != nil {
-			return fmt.Errorf("error reading soft quota: %s", err)
+			return diag.Errorf("error reading soft quota: %s", err)
 		}
 	}
 
@@ -0,0 +1,61 @@
+package other
+
+import (
+	"context"
+	"strings"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceRoutingRuleNpmScopes() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to generate the matchers of a routing rule for npm scopes.
+
+The matchers cover the metadata and tarball requests of all packages within the given scopes. To serve scoped packages only from a hosted repository, assign a ` + "`BLOCK`" + ` routing rule with these matchers to the npm proxy repository and group both repositories.
+This is only correct if the hosted repository serves all packages of these scopes, as the proxy no longer serves any of their metadata or tarballs.`,
+
+		ReadContext: dataSourceRoutingRuleNpmScopesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The comma separated list of npm scopes",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"scopes": {
+				Description: "List of npm scopes with or without the leading `@`, e.g. `@company`",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(tools.NpmScopeRegexp, "must be an npm scope name, e.g. @company"),
+				},
+			},
+			"matchers": {
+				Description: "Matchers of the request paths of all packages within the npm scopes, to be used in `nexus_routing_rule`",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRoutingRuleNpmScopesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	scopes := tools.InterfaceSliceToStringSlice(d.Get("scopes").([]interface{}))
+
+	matchers, err := tools.GetNpmScopeRoutingMatchers(scopes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join(scopes, ","))
+	if err := d.Set("matchers", matchers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
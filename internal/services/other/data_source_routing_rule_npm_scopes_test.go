@@ -0,0 +1,49 @@
+package other_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRoutingRuleNpmScopes(t *testing.T) {
+	dataSourceName := "data.nexus_routing_rule_npm_scopes.acceptance"
+	resName := "nexus_routing_rule.acceptance"
+	ruleName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRoutingRuleNpmScopesConfig(ruleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "@company,team"),
+					resource.TestCheckResourceAttr(dataSourceName, "matchers.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "matchers.0", "^/@company/.*"),
+					resource.TestCheckResourceAttr(dataSourceName, "matchers.1", "^/@company%2[fF].*"),
+					resource.TestCheckResourceAttr(dataSourceName, "matchers.2", "^/@team/.*"),
+					resource.TestCheckResourceAttr(dataSourceName, "matchers.3", "^/@team%2[fF].*"),
+					resource.TestCheckResourceAttr(resName, "matchers.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRoutingRuleNpmScopesConfig(ruleName string) string {
+	return fmt.Sprintf(`
+data "nexus_routing_rule_npm_scopes" "acceptance" {
+	scopes = ["@company", "team"]
+}
+
+resource "nexus_routing_rule" "acceptance" {
+	name     = "%s"
+	mode     = "BLOCK"
+	matchers = data.nexus_routing_rule_npm_scopes.acceptance.matchers
+}
+`, ruleName)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// repositoryMoveTaskType is the type of the Nexus Pro task
+	// "Admin - Change repository blob store"
+	repositoryMoveTaskType = "repository.move"
+)
+
+// repositoryMoveTaskPollInterval is the interval the state of the task is
+// polled in
+var repositoryMoveTaskPollInterval = 5 * time.Second
+
+// repositoryTimeouts returns the timeouts of the repository resources. An
+// update changing the blob store waits for the task moving the content, which
+// takes long for large repositories.
+func repositoryTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Update: schema.DefaultTimeout(60 * time.Minute),
+	}
+}
+
+// repositoryCustomizeDiffBlobStoreChange rejects blob store changes during
+// plan if Nexus does not run with a Pro license
+func repositoryCustomizeDiffBlobStoreChange(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("storage.0.blob_store_name") {
+		return nil
+	}
+
+	info, err := api.NewClient(m).GetSystemInformation(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Could not read the Nexus edition, the blob store change is checked again during apply", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if !info.Status.IsPro() {
+		oldName, newName := diff.GetChange("storage.0.blob_store_name")
+		return blobStoreChangeRequiresProError(diff.Id(), oldName.(string), newName.(string))
+	}
+	return nil
+}
+
+func blobStoreChangeRequiresProError(repositoryName string, oldName string, newName string) error {
+	return fmt.Errorf("changing the blob store of repository %q from %q to %q requires Nexus Pro. "+
+		"Recreate the repository instead, e.g. with `terraform apply -replace`, which deletes its content", repositoryName, oldName, newName)
+}
+
+// changeRepositoryBlobStore moves the content of the repository to the given
+// blob store with a temporary task and waits until the task has finished. As
+// the plan time check is skipped if the edition cannot be read, the edition is
+// checked again before the task is created.
+func changeRepositoryBlobStore(ctx context.Context, client *api.Client, repositoryName string, oldBlobStoreName string, blobStoreName string) error {
+	info, err := client.GetSystemInformation(ctx)
+	if err != nil {
+		return fmt.Errorf("could not read the Nexus edition to change the blob store of repository %q: %v", repositoryName, err)
+	}
+	if !info.Status.IsPro() {
+		return blobStoreChangeRequiresProError(repositoryName, oldBlobStoreName, blobStoreName)
+	}
+
+	tflog.Info(ctx, "Changing repository blob store", map[string]interface{}{
+		"repository": repositoryName,
+		"blob_store": blobStoreName,
+	})
+
+	return client.RunTemporaryTask(ctx, api.TaskTemplate{
+		Type:                  repositoryMoveTaskType,
+		Name:                  fmt.Sprintf("Terraform: change blob store of repository %s to %s", repositoryName, blobStoreName),
+		Enabled:               true,
+		NotificationCondition: "FAILURE",
+		Frequency:             api.TaskFrequency{Schedule: api.TaskScheduleManual},
+		Properties: map[string]string{
+			"repositoryName":      repositoryName,
+			"targetBlobStoreName": blobStoreName,
+		},
+	}, repositoryMoveTaskPollInterval)
+}
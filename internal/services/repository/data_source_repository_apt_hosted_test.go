@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -30,7 +31,8 @@ func TestAccDataSourceRepositoryAptHosted(t *testing.T) {
 			Distribution: "bullseye",
 		},
 		AptSigning: repository.AptSigning{
-			Keypair: "test-data-keypair",
+			Keypair:    acceptance.TestPGPKeypair,
+			Passphrase: tools.GetStringPointer(acceptance.TestPGPPassphrase),
 		},
 	}
 	dataSourceName := "data.nexus_repository_apt_hosted.acceptance"
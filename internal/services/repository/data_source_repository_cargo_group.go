@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryCargoGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get an existing group cargo repository.",
+
+		ReadContext: dataSourceRepositoryCargoGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.DataSourceID,
+			"name":   repositorySchema.DataSourceName,
+			"online": repositorySchema.DataSourceOnline,
+			// Group schemas
+			"group":   repositorySchema.DataSourceGroup,
+			"storage": repositorySchema.DataSourceStorage,
+		},
+	}
+}
+
+func dataSourceRepositoryCargoGroupRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceData.SetId(resourceData.Get("name").(string))
+
+	return resourceCargoGroupRepositoryRead(ctx, resourceData, m)
+}
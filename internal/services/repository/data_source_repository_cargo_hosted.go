@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryCargoHosted() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get an existing hosted cargo repository.",
+
+		ReadContext: dataSourceRepositoryCargoHostedRead,
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.DataSourceID,
+			"name":   repositorySchema.DataSourceName,
+			"online": repositorySchema.DataSourceOnline,
+			// Hosted schemas
+			"cleanup":   repositorySchema.DataSourceCleanup,
+			"component": repositorySchema.DataSourceComponent,
+			"storage":   repositorySchema.DataSourceHostedStorage,
+		},
+	}
+}
+
+func dataSourceRepositoryCargoHostedRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceData.SetId(resourceData.Get("name").(string))
+
+	return resourceCargoHostedRepositoryRead(ctx, resourceData, m)
+}
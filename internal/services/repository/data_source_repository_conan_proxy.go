@@ -1,16 +1,19 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func DataSourceRepositoryConanProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this data source to get an existing conan proxy repository.",
+		Description: "Use this data source to get an existing proxy conan repository.",
 
-		Read: dataSourceRepositoryConanProxyRead,
+		ReadContext: dataSourceRepositoryConanProxyRead,
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
@@ -19,6 +22,7 @@ func DataSourceRepositoryConanProxy() *schema.Resource {
 			"online": repositorySchema.DataSourceOnline,
 			// Proxy schemas
 			"cleanup":        repositorySchema.DataSourceCleanup,
+			"conan_proxy":    repositorySchema.DataSourceConanProxy,
 			"http_client":    repositorySchema.DataSourceHTTPClient,
 			"negative_cache": repositorySchema.DataSourceNegativeCache,
 			"proxy":          repositorySchema.DataSourceProxy,
@@ -28,8 +32,8 @@ func DataSourceRepositoryConanProxy() *schema.Resource {
 	}
 }
 
-func dataSourceRepositoryConanProxyRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceRepositoryConanProxyRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceConanProxyRepositoryRead(resourceData, m)
+	return resourceConanProxyRepositoryRead(ctx, resourceData, m)
 }
@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,7 +13,7 @@ func DataSourceRepositoryDockerProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to get an existing docker proxy repository.",
 
-		Read: dataSourceRepositoryDockerProxyRead,
+		ReadContext: dataSourceRepositoryDockerProxyRead,
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
@@ -42,6 +45,19 @@ func DataSourceRepositoryDockerProxy() *schema.Resource {
 							Computed:    true,
 							Type:        schema.TypeString,
 						},
+						"cache_foreign_layers": {
+							Description: "Whether to download and cache foreign layers",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						"foreign_layer_url_whitelist": {
+							Description: "Regular expressions matching the URLs of the foreign layers to cache",
+							Computed:    true,
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -49,8 +65,8 @@ func DataSourceRepositoryDockerProxy() *schema.Resource {
 	}
 }
 
-func dataSourceRepositoryDockerProxyRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceRepositoryDockerProxyRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceDockerProxyRepositoryRead(resourceData, m)
+	return resourceDockerProxyRepositoryRead(ctx, resourceData, m)
 }
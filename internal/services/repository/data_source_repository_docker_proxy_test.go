@@ -7,6 +7,7 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -19,14 +20,14 @@ data "nexus_repository_docker_proxy" "acceptance" {
 }
 
 func TestAccDataSourceRepositoryDockerProxy(t *testing.T) {
-	repoUsingDefaults := repository.DockerProxyRepository{
+	repoUsingDefaults := api.DockerProxyRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
 		Docker: repository.Docker{
 			ForceBasicAuth: true,
 			V1Enabled:      true,
 		},
-		DockerProxy: repository.DockerProxy{
+		DockerProxy: api.DockerProxy{
 			IndexType: repository.DockerProxyIndexTypeHub,
 		},
 		Proxy: repository.Proxy{
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryHuggingFaceProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get an existing Hugging Face proxy repository.",
+
+		ReadContext: dataSourceRepositoryHuggingFaceProxyRead,
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.DataSourceID,
+			"name":   repositorySchema.DataSourceName,
+			"online": repositorySchema.DataSourceOnline,
+			// Proxy schemas
+			"cleanup":        repositorySchema.DataSourceCleanup,
+			"http_client":    repositorySchema.DataSourceHTTPClient,
+			"negative_cache": repositorySchema.DataSourceNegativeCache,
+			"proxy":          repositorySchema.DataSourceProxy,
+			"routing_rule":   repositorySchema.DataSourceRoutingRule,
+			"storage":        repositorySchema.DataSourceStorage,
+		},
+	}
+}
+
+func dataSourceRepositoryHuggingFaceProxyRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceData.SetId(resourceData.Get("name").(string))
+
+	return resourceHuggingFaceProxyRepositoryRead(ctx, resourceData, m)
+}
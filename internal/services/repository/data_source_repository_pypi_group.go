@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,7 +13,7 @@ func DataSourceRepositoryPypiGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to get an existing pypi group repository.",
 
-		Read: dataSourceRepositoryPypiGroupRead,
+		ReadContext: dataSourceRepositoryPypiGroupRead,
 		Schema: map[string]*schema.Schema{
 			// Common schemas
 			"id":     common.DataSourceID,
@@ -23,8 +26,8 @@ func DataSourceRepositoryPypiGroup() *schema.Resource {
 	}
 }
 
-func dataSourceRepositoryPypiGroupRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceRepositoryPypiGroupRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourcePypiGroupRepositoryRead(resourceData, m)
+	return resourcePypiGroupRepositoryRead(ctx, resourceData, m)
 }
@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,7 +13,7 @@ func DataSourceRepositoryRGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to get an existing r group repository.",
 
-		Read: dataSourceRepositoryRGroupRead,
+		ReadContext: dataSourceRepositoryRGroupRead,
 		Schema: map[string]*schema.Schema{
 			// Common schemas
 			"id":     common.DataSourceID,
@@ -23,8 +26,8 @@ func DataSourceRepositoryRGroup() *schema.Resource {
 	}
 }
 
-func dataSourceRepositoryRGroupRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceRepositoryRGroupRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceRGroupRepositoryRead(resourceData, m)
+	return resourceRGroupRepositoryRead(ctx, resourceData, m)
 }
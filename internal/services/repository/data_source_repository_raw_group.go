@@ -19,6 +19,8 @@ func DataSourceRepositoryRawGroup() *schema.Resource {
 			// Group schemas
 			"group":   repository.DataSourceGroup,
 			"storage": repository.DataSourceStorage,
+			// Raw group schemas
+			"raw": repository.DataSourceRaw,
 		},
 	}
 }
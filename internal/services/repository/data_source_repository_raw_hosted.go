@@ -20,6 +20,8 @@ func DataSourceRepositoryRawHosted() *schema.Resource {
 			"cleanup":   repository.DataSourceCleanup,
 			"component": repository.DataSourceComponent,
 			"storage":   repository.DataSourceHostedStorage,
+			// Raw hosted schemas
+			"raw": repository.DataSourceRaw,
 		},
 	}
 }
@@ -42,6 +42,7 @@ func TestAccDataSourceRepositoryRawHosted(t *testing.T) {
 						resource.TestCheckResourceAttr(dataSourceName, "online", strconv.FormatBool(repoUsingDefaults.Online)),
 						resource.TestCheckResourceAttr(dataSourceName, "storage.0.blob_store_name", repoUsingDefaults.Storage.BlobStoreName),
 						resource.TestCheckResourceAttr(dataSourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repoUsingDefaults.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(dataSourceName, "raw.0.content_disposition", string(repository.RawContentDispositionAttachment)),
 					),
 				),
 			},
@@ -24,6 +24,8 @@ func DataSourceRepositoryRawProxy() *schema.Resource {
 			"proxy":          repositorySchema.DataSourceProxy,
 			"routing_rule":   repositorySchema.DataSourceRoutingRule,
 			"storage":        repositorySchema.DataSourceStorage,
+			// Raw proxy schemas
+			"raw": repositorySchema.DataSourceRaw,
 		},
 	}
 }
@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,7 +13,7 @@ func DataSourceRepositoryRubygemsGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to get an existing rubygems group repository.",
 
-		Read: dataSourceRepositoryRubygemsGroupRead,
+		ReadContext: dataSourceRepositoryRubygemsGroupRead,
 		Schema: map[string]*schema.Schema{
 			// Common schemas
 			"id":     common.DataSourceID,
@@ -23,8 +26,8 @@ func DataSourceRepositoryRubygemsGroup() *schema.Resource {
 	}
 }
 
-func dataSourceRepositoryRubygemsGroupRead(resourceData *schema.ResourceData, m interface{}) error {
+func dataSourceRepositoryRubygemsGroupRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceData.SetId(resourceData.Get("name").(string))
 
-	return resourceRubygemsGroupRepositoryRead(resourceData, m)
+	return resourceRubygemsGroupRepositoryRead(ctx, resourceData, m)
 }
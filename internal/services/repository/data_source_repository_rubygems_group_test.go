@@ -7,6 +7,7 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -20,14 +21,14 @@ data "nexus_repository_rubygems_group" "acceptance" {
 
 func TestAccDataSourceRepositoryRubygemsGroup(t *testing.T) {
 	repoHosted := testAccResourceRepositoryRubygemsHosted()
-	repoGroup := repository.RubyGemsGroupRepository{
+	repoGroup := api.RubyGemsGroupRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
 			StrictContentTypeValidation: false,
 		},
-		Group: repository.Group{
+		Group: repository.GroupDeploy{
 			MemberNames: []string{repoHosted.Name},
 		},
 	}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositorySigningKey() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to get the public key of a PGP signing key pair used by apt and yum repositories.
+
+Clients need the public key to verify the signed repository metadata. The key pair is only read locally and never sent to Nexus.`,
+
+		ReadContext: dataSourceRepositorySigningKeyRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Fingerprint of the PGP signing key pair",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"keypair": {
+				Description:  "PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: repositorySchema.ValidatePGPPrivateKey,
+			},
+			"passphrase": {
+				Description: "Passphrase to access PGP signing key",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"fingerprint": {
+				Description: "Fingerprint of the PGP signing key pair",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"key_id": {
+				Description: "Long key ID of the PGP signing key pair",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"public_key": {
+				Description: "Armored public key of the PGP signing key pair",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceRepositorySigningKeyRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, err := tools.GetPGPKey(resourceData.Get("keypair").(string), resourceData.Get("passphrase").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(key.Fingerprint)
+	resourceData.Set("fingerprint", key.Fingerprint)
+	resourceData.Set("key_id", key.KeyID)
+	resourceData.Set("public_key", key.PublicKey)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccDataSourceRepositorySigningKeyConfig() string {
+	return fmt.Sprintf(`
+data "nexus_repository_signing_key" "acceptance" {
+	keypair    = <<EOT
+%sEOT
+	passphrase = "%s"
+}`, acceptance.TestPGPKeypair, acceptance.TestPGPPassphrase)
+}
+
+func TestAccDataSourceRepositorySigningKey(t *testing.T) {
+	dataSourceName := "data.nexus_repository_signing_key.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRepositorySigningKeyConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", acceptance.TestPGPFingerprint),
+					resource.TestCheckResourceAttr(dataSourceName, "fingerprint", acceptance.TestPGPFingerprint),
+					resource.TestCheckResourceAttr(dataSourceName, "key_id", acceptance.TestPGPKeyID),
+					resource.TestCheckResourceAttrWith(dataSourceName, "public_key", func(value string) error {
+						if !strings.HasPrefix(value, "-----BEGIN PGP PUBLIC KEY BLOCK-----") {
+							return fmt.Errorf("expected an armored PGP public key, got %q", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
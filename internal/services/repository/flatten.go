@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,6 +18,14 @@ func flattenCleanup(cleanup *repository.Cleanup) []map[string]interface{} {
 	}
 }
 
+func flattenConanProxy(conanProxy *api.ConanProxy) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"conan_version": conanProxy.ConanVersion,
+		},
+	}
+}
+
 func flattenDocker(docker *repository.Docker) []map[string]interface{} {
 	data := map[string]interface{}{
 		"force_basic_auth": docker.ForceBasicAuth,
@@ -33,9 +42,11 @@ func flattenDocker(docker *repository.Docker) []map[string]interface{} {
 	return []map[string]interface{}{data}
 }
 
-func flattenDockerProxy(dockerProxy *repository.DockerProxy) []map[string]interface{} {
+func flattenDockerProxy(dockerProxy *api.DockerProxy) []map[string]interface{} {
 	data := map[string]interface{}{
-		"index_type": string(dockerProxy.IndexType),
+		"index_type":                  string(dockerProxy.IndexType),
+		"cache_foreign_layers":        dockerProxy.CacheForeignLayers,
+		"foreign_layer_url_whitelist": tools.StringSliceToInterfaceSlice(dockerProxy.ForeignLayerURLWhitelist),
 	}
 
 	if dockerProxy.IndexURL != nil {
@@ -60,6 +71,7 @@ func flattenGroup(group *repository.Group) []map[string]interface{} {
 	return []map[string]interface{}{
 		{
 			"member_names": tools.StringSliceToInterfaceSlice(group.MemberNames),
+			"members":      tools.StringSliceToInterfaceSlice(group.MemberNames),
 		},
 	}
 }
@@ -67,6 +79,7 @@ func flattenGroup(group *repository.Group) []map[string]interface{} {
 func flattenGroupDeploy(group *repository.GroupDeploy) []map[string]interface{} {
 	data := map[string]interface{}{
 		"member_names": tools.StringSliceToInterfaceSlice(group.MemberNames),
+		"members":      tools.StringSliceToInterfaceSlice(group.MemberNames),
 	}
 	if group.WritableMember != nil {
 		data["writable_member"] = *group.WritableMember
@@ -209,6 +222,15 @@ func flattenHostedStorage(storage *repository.HostedStorage) []map[string]interf
 	return []map[string]interface{}{data}
 }
 
+func flattenRaw(raw *repository.Raw) []map[string]interface{} {
+	data := map[string]interface{}{}
+	if raw.ContentDisposition != nil {
+		data["content_disposition"] = string(*raw.ContentDisposition)
+	}
+
+	return []map[string]interface{}{data}
+}
+
 func flattenMaven(maven *repository.Maven) []map[string]interface{} {
 	data := map[string]interface{}{
 		"version_policy": maven.VersionPolicy,
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// repositoryGroupMutexKV serializes all changes of the members of the same
+// group, because Nexus only supports replacing the whole repository.
+var repositoryGroupMutexKV = tools.NewMutexKV()
+
+// getGroupMemberNames returns the members of a group repository. The ordered
+// members are used if they are configured, the unordered member_names
+// otherwise. Both attributes are computed, so only the configuration tells
+// which one the user manages.
+func getGroupMemberNames(resourceData *schema.ResourceData) []string {
+	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
+
+	memberNames := []string{}
+	if groupMembersConfigured(resourceData) {
+		for _, name := range groupConfig["members"].([]interface{}) {
+			memberNames = append(memberNames, name.(string))
+		}
+		return memberNames
+	}
+
+	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+		memberNames = append(memberNames, name.(string))
+	}
+	return memberNames
+}
+
+func groupMembersConfigured(resourceData *schema.ResourceData) bool {
+	config := resourceData.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	group := config.GetAttr("group")
+	if group.IsNull() || !group.IsKnown() || group.LengthInt() == 0 {
+		return false
+	}
+	return !group.Index(cty.NumberIntVal(0)).GetAttr("members").IsNull()
+}
+
+// modifyRepositoryGroup does a read-modify-write of the members of the group
+// repository with the given name while holding the lock for that group. modify
+// gets the current members from Nexus, so it must only change the members it
+// manages.
+func modifyRepositoryGroup(ctx context.Context, client *api.Client, name string, modify func(memberNames []string) ([]string, error)) error {
+	repositoryGroupMutexKV.Lock(name)
+	defer repositoryGroupMutexKV.Unlock(name)
+
+	group, err := getRepositoryGroup(ctx, client, name)
+	if err != nil {
+		return err
+	}
+
+	memberNames, err := modify(append([]string{}, group.Group.MemberNames...))
+	if err != nil {
+		return err
+	}
+
+	return client.SetRepositoryGroupMembers(ctx, *group, memberNames)
+}
+
+// repositoryGroupUpdate is repositoryUpdate for group repositories
+func repositoryGroupUpdate(update schema.UpdateFunc) schema.UpdateContextFunc {
+	return repositoryGroupUpdateContext(func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(update(resourceData, m))
+	})
+}
+
+// repositoryGroupUpdateContext is repositoryUpdateContext for group
+// repositories. The update replaces the members, so it holds the lock for the
+// group and fails if the members in Nexus differ from the members last seen in
+// state.
+func repositoryGroupUpdateContext(update schema.UpdateContextFunc) schema.UpdateContextFunc {
+	update = repositoryUpdateContext(update)
+	return func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		name := resourceData.Id()
+		repositoryGroupMutexKV.Lock(name)
+		defer repositoryGroupMutexKV.Unlock(name)
+
+		group, err := getRepositoryGroup(ctx, api.NewClient(m), name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		lastSeen, _ := resourceData.GetChange("group.0.members")
+		if err := checkRepositoryGroupMembers(name, tools.InterfaceSliceToStringSlice(lastSeen.([]interface{})), group.Group.MemberNames); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return update(ctx, resourceData, m)
+	}
+}
+
+// checkRepositoryGroupMembers returns an error if the members of the group in
+// Nexus differ from the members last seen in state. Unknown members, e.g. of
+// resources created before the members were stored in state, are not checked.
+func checkRepositoryGroupMembers(name string, lastSeen []string, memberNames []string) error {
+	if len(lastSeen) == 0 || equalMemberNames(lastSeen, memberNames) {
+		return nil
+	}
+	return fmt.Errorf("members of group repository '%s' have been changed outside of Terraform: expected %v, found %v. "+
+		"Refresh the state and apply again", name, lastSeen, memberNames)
+}
+
+// getRepositoryGroup returns the settings of the group repository with the
+// given name or an error if it does not exist or is no group
+func getRepositoryGroup(ctx context.Context, client *api.Client, name string) (*api.RepositorySettings, error) {
+	repository, err := client.GetRepositorySettings(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if repository == nil {
+		return nil, fmt.Errorf("group repository '%s' does not exist", name)
+	}
+	if repository.Group == nil {
+		return nil, fmt.Errorf("repository '%s' is no group repository", name)
+	}
+	return repository, nil
+}
+
+func equalMemberNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// indexOfMember returns the position of the member in the group or -1
+func indexOfMember(memberNames []string, member string) int {
+	for i, name := range memberNames {
+		if strings.EqualFold(name, member) {
+			return i
+		}
+	}
+	return -1
+}
+
+// insertMember returns the members with the member inserted at the given
+// position. Positions beyond the end append the member.
+func insertMember(memberNames []string, member string, position int) []string {
+	if position < 0 || position > len(memberNames) {
+		position = len(memberNames)
+	}
+	result := make([]string, 0, len(memberNames)+1)
+	result = append(result, memberNames[:position]...)
+	result = append(result, member)
+	return append(result, memberNames[position:]...)
+}
+
+// removeMember returns the members without the member
+func removeMember(memberNames []string, member string) []string {
+	result := make([]string, 0, len(memberNames))
+	for _, name := range memberNames {
+		if !strings.EqualFold(name, member) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func repositoryGroupMemberID(group string, member string) string {
+	return fmt.Sprintf("%s/%s", group, member)
+}
+
+func parseRepositoryGroupMemberID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of id '%s', expected <group>/<member>", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// repositoryFormatR is the format of R repositories, which has no constant in
+// the nexus client
+const repositoryFormatR = "r"
+
+// repositoryCustomizeDiffGroupDeploy returns the CustomizeDiffFunc of group
+// repositories of the given format, which support group deployment in Nexus
+// Pro. It validates the writable member before checking for a blob store
+// change.
+func repositoryCustomizeDiffGroupDeploy(format string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		if err := validateGroupWritableMember(ctx, diff, m, format); err != nil {
+			return err
+		}
+		return repositoryCustomizeDiffBlobStoreChange(ctx, diff, m)
+	}
+}
+
+// validateGroupWritableMember checks that the writable member is one of the
+// group members and a hosted repository of the same format. Members which are
+// not known yet or do not exist yet, because they are created in the same
+// apply, are left to Nexus.
+func validateGroupWritableMember(ctx context.Context, diff *schema.ResourceDiff, m interface{}, format string) error {
+	if !diff.NewValueKnown("group.0.writable_member") {
+		return nil
+	}
+	writableMember := diff.Get("group.0.writable_member").(string)
+	if writableMember == "" {
+		return nil
+	}
+
+	if memberNames, ok := getConfiguredGroupMemberNames(diff); ok && indexOfMember(memberNames, writableMember) < 0 {
+		return fmt.Errorf("writable_member '%s' is not a member of the group", writableMember)
+	}
+
+	member, err := api.NewClient(m).GetRepositorySettings(ctx, writableMember)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return nil
+	}
+	if member.Type != repository.RepositoryTypeHosted || member.Format != format {
+		return fmt.Errorf("writable_member '%s' must be a %s hosted repository, but is a %s %s repository", writableMember, format, member.Format, member.Type)
+	}
+	return nil
+}
+
+// getConfiguredGroupMemberNames returns the configured members of a group
+// repository, either the ordered members or the member_names. The second
+// return value is false if the members are not known at plan time.
+func getConfiguredGroupMemberNames(diff *schema.ResourceDiff) ([]string, bool) {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil, false
+	}
+	group := config.GetAttr("group")
+	if group.IsNull() || !group.IsKnown() || group.LengthInt() == 0 {
+		return nil, false
+	}
+
+	groupConfig := group.Index(cty.NumberIntVal(0))
+	members := groupConfig.GetAttr("members")
+	if members.IsNull() {
+		members = groupConfig.GetAttr("member_names")
+	}
+	if members.IsNull() || !members.IsWhollyKnown() {
+		return nil, false
+	}
+
+	memberNames := []string{}
+	for it := members.ElementIterator(); it.Next(); {
+		_, member := it.Element()
+		if !member.IsNull() {
+			memberNames = append(memberNames, member.AsString())
+		}
+	}
+	return memberNames, true
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRepositoryGroupMembers(t *testing.T) {
+	assert.NoError(t, checkRepositoryGroupMembers("check-unknown", nil, []string{"a", "b"}))
+	assert.NoError(t, checkRepositoryGroupMembers("check-equal", []string{"a", "b"}, []string{"a", "b"}))
+	assert.ErrorContains(t, checkRepositoryGroupMembers("check-reordered", []string{"a", "b"}, []string{"b", "a"}), "have been changed outside of Terraform")
+
+}
+
+func TestClaimRepositoryGroupMemberPosition(t *testing.T) {
+	m := &struct{ name string }{"provider"}
+
+	assert.NoError(t, claimRepositoryGroupMemberPosition(m, "claim-group", "a", 0, []string{"x"}))
+	// a is at position 0 in Nexus
+	assert.ErrorContains(t, claimRepositoryGroupMemberPosition(m, "claim-group", "b", 0, []string{"a", "x"}), "are both configured at position 0")
+	// Positions of other groups and other providers are independent
+	assert.NoError(t, claimRepositoryGroupMemberPosition(m, "other-group", "b", 0, []string{"a", "x"}))
+	assert.NoError(t, claimRepositoryGroupMemberPosition(&struct{ name string }{"other"}, "claim-group", "b", 0, []string{"a", "x"}))
+	// a has been moved in Nexus since, so its claim is stale
+	assert.NoError(t, claimRepositoryGroupMemberPosition(m, "claim-group", "b", 0, []string{"x", "a"}))
+	// The position has been released
+	releaseRepositoryGroupMemberPosition(m, "claim-group", "b", 0)
+	assert.NoError(t, claimRepositoryGroupMemberPosition(m, "claim-group", "c", 0, []string{"b", "x"}))
+	// Unset positions append the member
+	assert.NoError(t, claimRepositoryGroupMemberPosition(m, "claim-group", "d", -1, []string{"c", "x"}))
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getProxyRepositoryFromResourceData returns the configuration of a proxy
+// repository of a format without format specific attributes
+func getProxyRepositoryFromResourceData(resourceData *schema.ResourceData) api.ProxyRepository {
+	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
+	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
+	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+
+	repo := api.ProxyRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.Storage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+		},
+		HTTPClient: repository.HTTPClient{
+			AutoBlock: httpClientConfig["auto_block"].(bool),
+			Blocked:   httpClientConfig["blocked"].(bool),
+		},
+		NegativeCache: repository.NegativeCache{
+			Enabled: negativeCacheConfig["enabled"].(bool),
+			TTL:     negativeCacheConfig["ttl"].(int),
+		},
+		Proxy: repository.Proxy{
+			ContentMaxAge:  proxyConfig["content_max_age"].(int),
+			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
+			RemoteURL:      proxyConfig["remote_url"].(string),
+		},
+	}
+
+	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
+		repo.RoutingRule = tools.GetStringPointer(routingRule.(string))
+		repo.RoutingRuleName = tools.GetStringPointer(routingRule.(string))
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["authentication"]; ok {
+		authList := v.([]interface{})
+		if len(authList) == 1 && authList[0] != nil {
+			authConfig := authList[0].(map[string]interface{})
+
+			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
+				NTLMDomain: authConfig["ntlm_domain"].(string),
+				NTLMHost:   authConfig["ntlm_host"].(string),
+				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:   authConfig["username"].(string),
+				Password:   authConfig["password"].(string),
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["connection"]; ok {
+		connectionList := v.([]interface{})
+		if len(connectionList) == 1 && connectionList[0] != nil {
+			connectionConfig := connectionList[0].(map[string]interface{})
+			repo.HTTPClient.Connection = &repository.HTTPClientConnection{
+				EnableCircularRedirects: tools.GetBoolPointer(connectionConfig["enable_circular_redirects"].(bool)),
+				EnableCookies:           tools.GetBoolPointer(connectionConfig["enable_cookies"].(bool)),
+				Retries:                 tools.GetIntPointer(connectionConfig["retries"].(int)),
+				Timeout:                 tools.GetIntPointer(connectionConfig["timeout"].(int)),
+				UserAgentSuffix:         connectionConfig["user_agent_suffix"].(string),
+				UseTrustStore:           tools.GetBoolPointer(connectionConfig["use_trust_store"].(bool)),
+			}
+		}
+	}
+
+	return repo
+}
+
+// setProxyRepositoryToResourceData sets the configuration of a proxy
+// repository of a format without format specific attributes
+func setProxyRepositoryToResourceData(repo *api.ProxyRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if repo.RoutingRuleName != nil {
+		resourceData.Set("routing_rule", repo.RoutingRuleName)
+	} else if repo.RoutingRule != nil {
+		resourceData.Set("routing_rule", repo.RoutingRule)
+	}
+
+	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("http_client", flattenHTTPClient(&repo.HTTPClient, resourceData)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(&repo.NegativeCache)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("proxy", flattenProxy(&repo.Proxy)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
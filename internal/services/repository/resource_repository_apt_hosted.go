@@ -13,20 +13,23 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted apt repository.",
 
-		Create: resourceAptHostedRepositoryCreate,
-		Delete: resourceAptHostedRepositoryDelete,
-		Exists: resourceAptHostedRepositoryExists,
-		Read:   resourceAptHostedRepositoryRead,
-		Update: resourceAptHostedRepositoryUpdate,
+		Create:        resourceAptHostedRepositoryCreate,
+		Delete:        resourceAptHostedRepositoryDelete,
+		Exists:        resourceAptHostedRepositoryExists,
+		Read:          resourceAptHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceAptHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffSigningKey("signing"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -37,6 +40,8 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 				Required:    true,
 				Type:        schema.TypeString,
 			},
+			"signing_key_fingerprint": repositorySchema.ResourceSigningKeyFingerprint,
+			"signing_key_id":          repositorySchema.ResourceSigningKeyID,
 			"signing": {
 				Description: "Signing contains signing data of hosted repositores of format Apt",
 				Type:        schema.TypeList,
@@ -45,10 +50,11 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"keypair": {
-							Description: "PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)",
-							Type:        schema.TypeString,
-							Required:    true,
-							Sensitive:   true,
+							Description:  "PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)",
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: repositorySchema.ValidatePGPPrivateKey,
 						},
 						"passphrase": {
 							Description: "Passphrase to access PGP signing key",
@@ -3,6 +3,7 @@ package repository_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
@@ -24,8 +25,8 @@ func testAccResourceRepositoryAptHosted() repository.AptHostedRepository {
 			Distribution: "buster",
 		},
 		AptSigning: repository.AptSigning{
-			Keypair:    "test-keypair",
-			Passphrase: tools.GetStringPointer("test-passphrase"),
+			Keypair:    acceptance.TestPGPKeypair,
+			Passphrase: tools.GetStringPointer(acceptance.TestPGPPassphrase),
 		},
 		Storage: repository.HostedStorage{
 			BlobStoreName:               "default",
@@ -81,6 +82,8 @@ func TestAccResourceRepositoryAptHosted(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "distribution", repo.Apt.Distribution),
 						resource.TestCheckResourceAttr(resourceName, "signing.0.keypair", repo.AptSigning.Keypair),
 						resource.TestCheckResourceAttr(resourceName, "signing.0.passphrase", string(*repo.AptSigning.Passphrase)),
+						resource.TestCheckResourceAttr(resourceName, "signing_key_fingerprint", acceptance.TestPGPFingerprint),
+						resource.TestCheckResourceAttr(resourceName, "signing_key_id", acceptance.TestPGPKeyID),
 					),
 				),
 			},
@@ -90,7 +93,24 @@ func TestAccResourceRepositoryAptHosted(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Signing block is not returned
-				ImportStateVerifyIgnore: []string{"signing"},
+				ImportStateVerifyIgnore: []string{"signing", "signing_key_fingerprint", "signing_key_id"},
+			},
+		},
+	})
+}
+
+func TestAccResourceRepositoryAptHostedSigningKeyValidation(t *testing.T) {
+	repo := testAccResourceRepositoryAptHosted()
+	repo.AptSigning.Passphrase = tools.GetStringPointer("wrong-passphrase")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRepositoryAptHostedConfig(repo),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("passphrase does not decrypt the PGP private key"),
 			},
 		},
 	})
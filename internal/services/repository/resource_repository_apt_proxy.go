@@ -13,20 +13,23 @@ func ResourceRepositoryAptProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted apt repository.",
 
-		Create: resourceAptProxyRepositoryCreate,
-		Delete: resourceAptProxyRepositoryDelete,
-		Exists: resourceAptProxyRepositoryExists,
-		Read:   resourceAptProxyRepositoryRead,
-		Update: resourceAptProxyRepositoryUpdate,
+		Create:        resourceAptProxyRepositoryCreate,
+		Delete:        resourceAptProxyRepositoryDelete,
+		Exists:        resourceAptProxyRepositoryExists,
+		Read:          resourceAptProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceAptProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
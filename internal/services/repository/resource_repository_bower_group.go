@@ -12,20 +12,23 @@ func ResourceRepositoryBowerGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group bower repository.",
 
-		Create: resourceBowerGroupRepositoryCreate,
-		Delete: resourceBowerGroupRepositoryDelete,
-		Exists: resourceBowerGroupRepositoryExists,
-		Read:   resourceBowerGroupRepositoryRead,
-		Update: resourceBowerGroupRepositoryUpdate,
+		Create:        resourceBowerGroupRepositoryCreate,
+		Delete:        resourceBowerGroupRepositoryDelete,
+		Exists:        resourceBowerGroupRepositoryExists,
+		Read:          resourceBowerGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceBowerGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
@@ -35,11 +38,7 @@ func ResourceRepositoryBowerGroup() *schema.Resource {
 
 func getBowerGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.BowerGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
-	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.BowerGroupRepository{
 		Name:   resourceData.Get("name").(string),
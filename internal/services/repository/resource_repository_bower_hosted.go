@@ -13,20 +13,23 @@ func ResourceRepositoryBowerHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Bower repository.",
 
-		Create: resourceBowerHostedRepositoryCreate,
-		Delete: resourceBowerHostedRepositoryDelete,
-		Exists: resourceBowerHostedRepositoryExists,
-		Read:   resourceBowerHostedRepositoryRead,
-		Update: resourceBowerHostedRepositoryUpdate,
+		Create:        resourceBowerHostedRepositoryCreate,
+		Delete:        resourceBowerHostedRepositoryDelete,
+		Exists:        resourceBowerHostedRepositoryExists,
+		Read:          resourceBowerHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceBowerHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
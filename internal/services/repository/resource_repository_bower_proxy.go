@@ -13,20 +13,23 @@ func ResourceRepositoryBowerProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an bower proxy repository.",
 
-		Create: resourceBowerProxyRepositoryCreate,
-		Delete: resourceBowerProxyRepositoryDelete,
-		Exists: resourceBowerProxyRepositoryExists,
-		Read:   resourceBowerProxyRepositoryRead,
-		Update: resourceBowerProxyRepositoryUpdate,
+		Create:        resourceBowerProxyRepositoryCreate,
+		Delete:        resourceBowerProxyRepositoryDelete,
+		Exists:        resourceBowerProxyRepositoryExists,
+		Read:          resourceBowerProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceBowerProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryCargoGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a group cargo repository.",
+
+		CreateContext: resourceCargoGroupRepositoryCreate,
+		DeleteContext: resourceCargoGroupRepositoryDelete,
+		ReadContext:   resourceCargoGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdateContext(resourceCargoGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: repositoryTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
+			// Group schemas
+			"group":   repositorySchema.ResourceGroup,
+			"storage": repositorySchema.ResourceStorage,
+		},
+	}
+}
+
+func getCargoGroupRepositoryFromResourceData(resourceData *schema.ResourceData) api.CargoGroupRepository {
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+	groupMemberNames := getGroupMemberNames(resourceData)
+
+	repo := api.CargoGroupRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.Storage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+		},
+		Group: repository.Group{
+			MemberNames: groupMemberNames,
+		},
+	}
+
+	return repo
+}
+
+func setCargoGroupRepositoryToResourceData(repo *api.CargoGroupRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("group", flattenGroup(&repo.Group)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceCargoGroupRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo := getCargoGroupRepositoryFromResourceData(resourceData)
+
+	if err := client.CreateCargoGroupRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return resourceCargoGroupRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceCargoGroupRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo, err := client.GetCargoGroupRepository(ctx, resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(setCargoGroupRepositoryToResourceData(repo, resourceData))
+}
+
+func resourceCargoGroupRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repoName := resourceData.Id()
+	repo := getCargoGroupRepositoryFromResourceData(resourceData)
+
+	if err := client.UpdateCargoGroupRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCargoGroupRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceCargoGroupRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
+}
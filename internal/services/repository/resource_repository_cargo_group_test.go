@@ -0,0 +1,79 @@
+package repository_test
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+	"text/template"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryCargoGroup() api.CargoGroupRepository {
+	return api.CargoGroupRepository{
+		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+		Online: true,
+		Storage: repository.Storage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+		},
+		Group: repository.Group{
+			MemberNames: []string{},
+		},
+	}
+}
+
+func testAccResourceRepositoryCargoGroupConfig(repo api.CargoGroupRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryCargoGroupTemplate := template.Must(template.New("CargoGroupRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryCargoGroup))
+	if err := resourceRepositoryCargoGroupTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryCargoGroup(t *testing.T) {
+	repoHosted := testAccResourceRepositoryCargoHosted()
+	repo := testAccResourceRepositoryCargoGroup()
+	repo.Group.MemberNames = append(repo.Group.MemberNames, repoHosted.Name)
+	resourceName := "nexus_repository_cargo_group.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.AccPreCheck(t)
+			acceptance.SkipIfNexusVersionBelow(t, "3.73.0")
+		},
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryCargoHostedConfig(repoHosted) + testAccResourceRepositoryCargoGroupConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(resourceName, "group.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.0", repo.Group.MemberNames[0]),
+					),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
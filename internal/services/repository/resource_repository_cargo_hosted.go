@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryCargoHosted() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a hosted cargo repository.",
+
+		CreateContext: resourceCargoHostedRepositoryCreate,
+		DeleteContext: resourceCargoHostedRepositoryDelete,
+		ReadContext:   resourceCargoHostedRepositoryRead,
+		UpdateContext: repositoryUpdateContext(resourceCargoHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: repositoryTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
+			// Hosted schemas
+			"cleanup":   repositorySchema.ResourceCleanup,
+			"component": repositorySchema.ResourceComponent,
+			"storage":   repositorySchema.ResourceHostedStorage,
+		},
+	}
+}
+
+func getCargoHostedRepositoryFromResourceData(resourceData *schema.ResourceData) api.CargoHostedRepository {
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
+
+	repo := api.CargoHostedRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.HostedStorage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+			WritePolicy:                 &writePolicy,
+		},
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	componentList := resourceData.Get("component").([]interface{})
+	if len(componentList) > 0 && componentList[0] != nil {
+		componentConfig := componentList[0].(map[string]interface{})
+		if len(componentConfig) > 0 {
+			repo.Component = &repository.Component{
+				ProprietaryComponents: componentConfig["proprietary_components"].(bool),
+			}
+		}
+	}
+
+	return repo
+}
+
+func setCargoHostedRepositoryToResourceData(repo *api.CargoHostedRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if err := resourceData.Set("storage", flattenHostedStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+			return err
+		}
+	}
+
+	if repo.Component != nil {
+		if err := resourceData.Set("component", flattenComponent(repo.Component)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceCargoHostedRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo := getCargoHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.CreateCargoHostedRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return resourceCargoHostedRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceCargoHostedRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo, err := client.GetCargoHostedRepository(ctx, resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(setCargoHostedRepositoryToResourceData(repo, resourceData))
+}
+
+func resourceCargoHostedRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repoName := resourceData.Id()
+	repo := getCargoHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.UpdateCargoHostedRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCargoHostedRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceCargoHostedRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
+}
@@ -0,0 +1,86 @@
+package repository_test
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+	"text/template"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryCargoHosted() api.CargoHostedRepository {
+	writePolicy := repository.StorageWritePolicyAllow
+
+	return api.CargoHostedRepository{
+		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+		Online: true,
+		Storage: repository.HostedStorage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+			WritePolicy:                 &writePolicy,
+		},
+		Cleanup: &repository.Cleanup{
+			PolicyNames: []string{"cleanup-weekly"},
+		},
+		Component: &repository.Component{
+			ProprietaryComponents: true,
+		},
+	}
+}
+
+func testAccResourceRepositoryCargoHostedConfig(repo api.CargoHostedRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryCargoHostedTemplate := template.Must(template.New("CargoHostedRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryCargoHosted))
+	if err := resourceRepositoryCargoHostedTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryCargoHosted(t *testing.T) {
+	repo := testAccResourceRepositoryCargoHosted()
+	resourceName := "nexus_repository_cargo_hosted.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.AccPreCheck(t)
+			acceptance.SkipIfNexusVersionBelow(t, "3.73.0")
+		},
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryCargoHostedConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.write_policy", string(*repo.Storage.WritePolicy)),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
+						resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "component.0.proprietary_components", strconv.FormatBool(repo.Component.ProprietaryComponents)),
+					),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
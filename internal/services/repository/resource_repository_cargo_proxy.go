@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryCargoProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a cargo proxy repository.",
+
+		CreateContext: resourceCargoProxyRepositoryCreate,
+		DeleteContext: resourceCargoProxyRepositoryDelete,
+		ReadContext:   resourceCargoProxyRepositoryRead,
+		UpdateContext: repositoryUpdateContext(resourceCargoProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: repositoryTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
+			// Proxy schemas
+			"cleanup":        repositorySchema.ResourceCleanup,
+			"http_client":    repositorySchema.ResourceHTTPClient,
+			"negative_cache": repositorySchema.ResourceNegativeCache,
+			"proxy":          repositorySchema.ResourceProxy,
+			"routing_rule":   repositorySchema.ResourceRoutingRule,
+			"storage":        repositorySchema.ResourceStorage,
+		},
+	}
+}
+
+func resourceCargoProxyRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo := api.CargoProxyRepository(getProxyRepositoryFromResourceData(resourceData))
+
+	if err := client.CreateCargoProxyRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return resourceCargoProxyRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceCargoProxyRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo, err := client.GetCargoProxyRepository(ctx, resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(setProxyRepositoryToResourceData((*api.ProxyRepository)(repo), resourceData))
+}
+
+func resourceCargoProxyRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repoName := resourceData.Id()
+	repo := api.CargoProxyRepository(getProxyRepositoryFromResourceData(resourceData))
+
+	if err := client.UpdateCargoProxyRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCargoProxyRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceCargoProxyRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
+}
@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryCargoProxy() api.CargoProxyRepository {
+	return api.CargoProxyRepository(testAccResourceRepositoryProxy("https://index.crates.io/"))
+}
+
+func testAccResourceRepositoryCargoProxyConfig(repo api.CargoProxyRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryCargoProxyTemplate := template.Must(template.New("CargoProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryCargoProxy))
+	if err := resourceRepositoryCargoProxyTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryCargoProxy(t *testing.T) {
+	routingRule := schema.RoutingRule{
+		Name:        acctest.RandString(10),
+		Description: "acceptance test",
+		Mode:        schema.RoutingRuleModeAllow,
+		Matchers: []string{
+			"/",
+		},
+	}
+	repo := testAccResourceRepositoryCargoProxy()
+	repo.RoutingRule = &routingRule.Name
+	resourceName := "nexus_repository_cargo_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.AccPreCheck(t)
+			acceptance.SkipIfNexusVersionBelow(t, "3.73.0")
+		},
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRoutingRuleConfig(routingRule) + testAccResourceRepositoryCargoProxyConfig(repo),
+				Check:  testAccResourceRepositoryProxyCheck(resourceName, api.ProxyRepository(repo)),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password"},
+			},
+		},
+	})
+}
@@ -13,20 +13,23 @@ func ResourceRepositoryCocoapodsProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an cocoapods proxy repository.",
 
-		Create: resourceCocoapodsProxyRepositoryCreate,
-		Delete: resourceCocoapodsProxyRepositoryDelete,
-		Exists: resourceCocoapodsProxyRepositoryExists,
-		Read:   resourceCocoapodsProxyRepositoryRead,
-		Update: resourceCocoapodsProxyRepositoryUpdate,
+		Create:        resourceCocoapodsProxyRepositoryCreate,
+		Delete:        resourceCocoapodsProxyRepositoryDelete,
+		Exists:        resourceCocoapodsProxyRepositoryExists,
+		Read:          resourceCocoapodsProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceCocoapodsProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
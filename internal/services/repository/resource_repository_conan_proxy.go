@@ -1,34 +1,40 @@
 package repository
 
 import (
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"context"
+
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryConanProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create an conan proxy repository.",
+		Description: "Use this resource to create a conan proxy repository.",
 
-		Create: resourceConanProxyRepositoryCreate,
-		Delete: resourceConanProxyRepositoryDelete,
-		Exists: resourceConanProxyRepositoryExists,
-		Read:   resourceConanProxyRepositoryRead,
-		Update: resourceConanProxyRepositoryUpdate,
+		CreateContext: resourceConanProxyRepositoryCreate,
+		DeleteContext: resourceConanProxyRepositoryDelete,
+		ReadContext:   resourceConanProxyRepositoryRead,
+		UpdateContext: repositoryUpdateContext(resourceConanProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
+			"conan_proxy":    repositorySchema.ResourceConanProxy,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
@@ -38,13 +44,13 @@ func ResourceRepositoryConanProxy() *schema.Resource {
 	}
 }
 
-func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.ConanProxyRepository {
+func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData) api.ConanProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
 	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.ConanProxyRepository{
+	repo := api.ConanProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
@@ -66,6 +72,13 @@ func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		},
 	}
 
+	if conanProxyList := resourceData.Get("conan_proxy").([]interface{}); len(conanProxyList) > 0 && conanProxyList[0] != nil {
+		conanProxyConfig := conanProxyList[0].(map[string]interface{})
+		repo.ConanProxy = &api.ConanProxy{
+			ConanVersion: conanProxyConfig["conan_version"].(string),
+		}
+	}
+
 	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
 		repo.RoutingRule = tools.GetStringPointer(routingRule.(string))
 		repo.RoutingRuleName = tools.GetStringPointer(routingRule.(string))
@@ -117,7 +130,7 @@ func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 	return repo
 }
 
-func setConanProxyRepositoryToResourceData(repo *repository.ConanProxyRepository, resourceData *schema.ResourceData) error {
+func setConanProxyRepositoryToResourceData(repo *api.ConanProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -149,28 +162,34 @@ func setConanProxyRepositoryToResourceData(repo *repository.ConanProxyRepository
 			return err
 		}
 	}
+
+	if repo.ConanProxy != nil {
+		if err := resourceData.Set("conan_proxy", flattenConanProxy(repo.ConanProxy)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func resourceConanProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceConanProxyRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repo := getConanProxyRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Conan.Proxy.Create(repo); err != nil {
-		return err
+	if err := client.CreateConanProxyRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceConanProxyRepositoryRead(resourceData, m)
+	return resourceConanProxyRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceConanProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceConanProxyRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
-	repo, err := client.Repository.Conan.Proxy.Get(resourceData.Id())
+	repo, err := client.GetConanProxyRepository(ctx, resourceData.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if repo == nil {
@@ -178,30 +197,23 @@ func resourceConanProxyRepositoryRead(resourceData *schema.ResourceData, m inter
 		return nil
 	}
 
-	return setConanProxyRepositoryToResourceData(repo, resourceData)
+	return diag.FromErr(setConanProxyRepositoryToResourceData(repo, resourceData))
 }
 
-func resourceConanProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceConanProxyRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repoName := resourceData.Id()
 	repo := getConanProxyRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Conan.Proxy.Update(repoName, repo); err != nil {
-		return err
+	if err := client.UpdateConanProxyRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceConanProxyRepositoryRead(resourceData, m)
+	return resourceConanProxyRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceConanProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Conan.Proxy.Delete(resourceData.Id())
-}
-
-func resourceConanProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
-
-	repo, err := client.Repository.Conan.Proxy.Get(resourceData.Id())
-	return repo != nil, err
+func resourceConanProxyRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
 }
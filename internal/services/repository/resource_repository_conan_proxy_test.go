@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryConanProxy() repository.ConanProxyRepository {
+func testAccResourceRepositoryConanProxy() api.ConanProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.ConanProxyRepository{
+	return api.ConanProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -60,7 +61,7 @@ func testAccResourceRepositoryConanProxy() repository.ConanProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryConanProxyConfig(repo repository.ConanProxyRepository) string {
+func testAccResourceRepositoryConanProxyConfig(repo api.ConanProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryConanProxyTemplate := template.Must(template.New("ConanProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryConanProxy))
 	if err := resourceRepositoryConanProxyTemplate.Execute(buf, repo); err != nil {
@@ -136,3 +137,37 @@ func TestAccResourceRepositoryConanProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryConanProxyV2(t *testing.T) {
+	repo := testAccResourceRepositoryConanProxy()
+	repo.Proxy.RemoteURL = "https://center2.conan.io"
+	repo.ConanProxy = &api.ConanProxy{
+		ConanVersion: api.ConanVersionV2,
+	}
+	resourceName := "nexus_repository_conan_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.AccPreCheck(t)
+			acceptance.SkipIfNexusVersionBelow(t, "3.77.0")
+		},
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryConanProxyConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "conan_proxy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conan_proxy.0.conan_version", api.ConanVersionV2),
+					resource.TestCheckResourceAttr(resourceName, "proxy.0.remote_url", repo.Proxy.RemoteURL),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password"},
+			},
+		},
+	})
+}
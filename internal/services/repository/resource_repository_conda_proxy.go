@@ -13,20 +13,23 @@ func ResourceRepositoryCondaProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an conda proxy repository.",
 
-		Create: resourceCondaProxyRepositoryCreate,
-		Delete: resourceCondaProxyRepositoryDelete,
-		Exists: resourceCondaProxyRepositoryExists,
-		Read:   resourceCondaProxyRepositoryRead,
-		Update: resourceCondaProxyRepositoryUpdate,
+		Create:        resourceCondaProxyRepositoryCreate,
+		Delete:        resourceCondaProxyRepositoryDelete,
+		Exists:        resourceCondaProxyRepositoryExists,
+		Read:          resourceCondaProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceCondaProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
@@ -13,20 +13,23 @@ func ResourceRepositoryDockerGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group docker repository.",
 
-		Create: resourceDockerGroupRepositoryCreate,
-		Delete: resourceDockerGroupRepositoryDelete,
-		Exists: resourceDockerGroupRepositoryExists,
-		Read:   resourceDockerGroupRepositoryRead,
-		Update: resourceDockerGroupRepositoryUpdate,
+		Create:        resourceDockerGroupRepositoryCreate,
+		Delete:        resourceDockerGroupRepositoryDelete,
+		Exists:        resourceDockerGroupRepositoryExists,
+		Read:          resourceDockerGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceDockerGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffGroupDeploy(repository.RepositoryFormatDocker),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroupDeploy,
 			"storage": repositorySchema.ResourceStorage,
@@ -40,10 +43,7 @@ func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData)
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.DockerGroupRepository{
 		Name:   resourceData.Get("name").(string),
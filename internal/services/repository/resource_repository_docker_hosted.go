@@ -13,20 +13,23 @@ func ResourceRepositoryDockerHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted docker repository.",
 
-		Create: resourceDockerHostedRepositoryCreate,
-		Delete: resourceDockerHostedRepositoryDelete,
-		Exists: resourceDockerHostedRepositoryExists,
-		Read:   resourceDockerHostedRepositoryRead,
-		Update: resourceDockerHostedRepositoryUpdate,
+		Create:        resourceDockerHostedRepositoryCreate,
+		Delete:        resourceDockerHostedRepositoryDelete,
+		Exists:        resourceDockerHostedRepositoryExists,
+		Read:          resourceDockerHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceDockerHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -1,14 +1,17 @@
 package repository
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"strings"
 
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,20 +20,22 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a docker proxy repository.",
 
-		Create: resourceDockerProxyRepositoryCreate,
-		Delete: resourceDockerProxyRepositoryDelete,
-		Exists: resourceDockerProxyRepositoryExists,
-		Read:   resourceDockerProxyRepositoryRead,
-		Update: resourceDockerProxyRepositoryUpdate,
+		CreateContext: resourceDockerProxyRepositoryCreate,
+		DeleteContext: resourceDockerProxyRepositoryDelete,
+		ReadContext:   resourceDockerProxyRepositoryRead,
+		UpdateContext: repositoryUpdateContext(resourceDockerProxyRepositoryUpdate),
+		CustomizeDiff: resourceDockerProxyRepositoryCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
@@ -59,6 +64,21 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 							Type:         schema.TypeString,
 							ValidateFunc: validation.StringMatch(regexp.MustCompile("http[s]?://.*"), "index_url should be in the format 'http://www.example.com'"),
 						},
+						"cache_foreign_layers": {
+							Description: "Whether to download and cache foreign layers, e.g. the layers of Windows base images",
+							Optional:    true,
+							Default:     false,
+							Type:        schema.TypeBool,
+						},
+						"foreign_layer_url_whitelist": {
+							Description: "Regular expressions matching the URLs of the foreign layers to cache. Required if `cache_foreign_layers` is `true`",
+							Optional:    true,
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
 					},
 				},
 			},
@@ -66,7 +86,24 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 	}
 }
 
-func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.DockerProxyRepository {
+// resourceDockerProxyRepositoryCustomizeDiff requires the foreign layer URL
+// whitelist if and only if foreign layers are cached
+func resourceDockerProxyRepositoryCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.NewValueKnown("docker_proxy.0.cache_foreign_layers") && diff.NewValueKnown("docker_proxy.0.foreign_layer_url_whitelist") {
+		cacheForeignLayers := diff.Get("docker_proxy.0.cache_foreign_layers").(bool)
+		whitelist := diff.Get("docker_proxy.0.foreign_layer_url_whitelist").(*schema.Set)
+		if cacheForeignLayers && whitelist.Len() == 0 {
+			return fmt.Errorf("docker_proxy.0.foreign_layer_url_whitelist is required when cache_foreign_layers is true")
+		}
+		if !cacheForeignLayers && whitelist.Len() > 0 {
+			return fmt.Errorf("docker_proxy.0.foreign_layer_url_whitelist can only be set when cache_foreign_layers is true")
+		}
+	}
+
+	return repositoryCustomizeDiffBlobStoreChange(ctx, diff, m)
+}
+
+func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) api.DockerProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
 	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
@@ -74,7 +111,7 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 	dockerProxyConfig := resourceData.Get("docker_proxy").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.DockerProxyRepository{
+	repo := api.DockerProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
@@ -85,8 +122,9 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 			ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
 			V1Enabled:      dockerConfig["v1_enabled"].(bool),
 		},
-		DockerProxy: repository.DockerProxy{
-			IndexType: repository.DockerProxyIndexType(dockerProxyConfig["index_type"].(string)),
+		DockerProxy: api.DockerProxy{
+			IndexType:          repository.DockerProxyIndexType(dockerProxyConfig["index_type"].(string)),
+			CacheForeignLayers: dockerProxyConfig["cache_foreign_layers"].(bool),
 		},
 		HTTPClient: repository.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
@@ -119,6 +157,10 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		repo.DockerProxy.IndexURL = tools.GetStringPointer(strings.TrimSpace(dockerProxyConfig["index_url"].(string)))
 	}
 
+	if whitelist, ok := dockerProxyConfig["foreign_layer_url_whitelist"].(*schema.Set); ok && whitelist.Len() > 0 {
+		repo.DockerProxy.ForeignLayerURLWhitelist = tools.InterfaceSliceToStringSlice(whitelist.List())
+	}
+
 	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
 		repo.RoutingRule = tools.GetStringPointer(routingRule.(string))
 		repo.RoutingRuleName = tools.GetStringPointer(routingRule.(string))
@@ -170,7 +212,7 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 	return repo
 }
 
-func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyRepository, resourceData *schema.ResourceData) error {
+func setDockerProxyRepositoryToResourceData(repo *api.DockerProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -213,25 +255,25 @@ func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyReposito
 	return nil
 }
 
-func resourceDockerProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceDockerProxyRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repo := getDockerProxyRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Proxy.Create(repo); err != nil {
-		return err
+	if err := client.CreateDockerProxyRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceDockerProxyRepositoryRead(resourceData, m)
+	return resourceDockerProxyRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceDockerProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceDockerProxyRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
-	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
+	repo, err := client.GetDockerProxyRepository(ctx, resourceData.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if repo == nil {
@@ -239,30 +281,23 @@ func resourceDockerProxyRepositoryRead(resourceData *schema.ResourceData, m inte
 		return nil
 	}
 
-	return setDockerProxyRepositoryToResourceData(repo, resourceData)
+	return diag.FromErr(setDockerProxyRepositoryToResourceData(repo, resourceData))
 }
 
-func resourceDockerProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceDockerProxyRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repoName := resourceData.Id()
 	repo := getDockerProxyRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Proxy.Update(repoName, repo); err != nil {
-		return err
+	if err := client.UpdateDockerProxyRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceDockerProxyRepositoryRead(resourceData, m)
-}
-
-func resourceDockerProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Docker.Proxy.Delete(resourceData.Id())
+	return resourceDockerProxyRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceDockerProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
-
-	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
-	return repo != nil, err
+func resourceDockerProxyRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
 }
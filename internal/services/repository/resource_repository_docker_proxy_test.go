@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math/rand"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
@@ -11,24 +12,30 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryDockerProxy() repository.DockerProxyRepository {
+func testAccResourceRepositoryDockerProxy() api.DockerProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.DockerProxyRepository{
+	return api.DockerProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
-		DockerProxy: repository.DockerProxy{
-			IndexType: repository.DockerProxyIndexTypeRegistry,
-			IndexURL:  tools.GetStringPointer("https://docker.elastic.co/index.json"),
+		DockerProxy: api.DockerProxy{
+			IndexType:          repository.DockerProxyIndexTypeRegistry,
+			IndexURL:           tools.GetStringPointer("https://docker.elastic.co/index.json"),
+			CacheForeignLayers: true,
+			ForeignLayerURLWhitelist: []string{
+				"https://go.microsoft.com/.*",
+				"https://mcr.microsoft.com/.*",
+			},
 		},
 		Docker: repository.Docker{
 			ForceBasicAuth: false,
@@ -72,7 +79,7 @@ func testAccResourceRepositoryDockerProxy() repository.DockerProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryDockerProxyConfig(repo repository.DockerProxyRepository) string {
+func testAccResourceRepositoryDockerProxyConfig(repo api.DockerProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryDockerProxyTemplate := template.Must(template.New("DockerProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryDockerProxy))
 	if err := resourceRepositoryDockerProxyTemplate.Execute(buf, repo); err != nil {
@@ -145,6 +152,10 @@ func TestAccResourceRepositoryDockerProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.index_type", string(repo.DockerProxy.IndexType)),
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.index_url", *repo.DockerProxy.IndexURL),
+						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.cache_foreign_layers", strconv.FormatBool(repo.DockerProxy.CacheForeignLayers)),
+						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.#", strconv.Itoa(len(repo.DockerProxy.ForeignLayerURLWhitelist))),
+						resource.TestCheckTypeSetElemAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.*", repo.DockerProxy.ForeignLayerURLWhitelist[0]),
+						resource.TestCheckTypeSetElemAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.*", repo.DockerProxy.ForeignLayerURLWhitelist[1]),
 					),
 				),
 			},
@@ -158,3 +169,30 @@ func TestAccResourceRepositoryDockerProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryDockerProxyForeignLayerValidation(t *testing.T) {
+	withoutWhitelist := testAccResourceRepositoryDockerProxy()
+	withoutWhitelist.Cleanup = nil
+	withoutWhitelist.DockerProxy.ForeignLayerURLWhitelist = nil
+
+	withoutCaching := testAccResourceRepositoryDockerProxy()
+	withoutCaching.Cleanup = nil
+	withoutCaching.DockerProxy.CacheForeignLayers = false
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRepositoryDockerProxyConfig(withoutWhitelist),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("foreign_layer_url_whitelist is required"),
+			},
+			{
+				Config:      testAccResourceRepositoryDockerProxyConfig(withoutCaching),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("foreign_layer_url_whitelist can only be set"),
+			},
+		},
+	})
+}
@@ -13,20 +13,23 @@ func ResourceRepositoryGitlfsHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted gitlfs repository.",
 
-		Create: resourceGitlfsHostedRepositoryCreate,
-		Delete: resourceGitlfsHostedRepositoryDelete,
-		Exists: resourceGitlfsHostedRepositoryExists,
-		Read:   resourceGitlfsHostedRepositoryRead,
-		Update: resourceGitlfsHostedRepositoryUpdate,
+		Create:        resourceGitlfsHostedRepositoryCreate,
+		Delete:        resourceGitlfsHostedRepositoryDelete,
+		Exists:        resourceGitlfsHostedRepositoryExists,
+		Read:          resourceGitlfsHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceGitlfsHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -12,20 +12,23 @@ func ResourceRepositoryGoGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group go repository.",
 
-		Create: resourceGoGroupRepositoryCreate,
-		Delete: resourceGoGroupRepositoryDelete,
-		Exists: resourceGoGroupRepositoryExists,
-		Read:   resourceGoGroupRepositoryRead,
-		Update: resourceGoGroupRepositoryUpdate,
+		Create:        resourceGoGroupRepositoryCreate,
+		Delete:        resourceGoGroupRepositoryDelete,
+		Exists:        resourceGoGroupRepositoryExists,
+		Read:          resourceGoGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceGoGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
@@ -35,11 +38,7 @@ func ResourceRepositoryGoGroup() *schema.Resource {
 
 func getGoGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.GoGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
-	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.GoGroupRepository{
 		Name:   resourceData.Get("name").(string),
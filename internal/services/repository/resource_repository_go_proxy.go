@@ -13,20 +13,23 @@ func ResourceRepositoryGoProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a go proxy repository.",
 
-		Create: resourceGoProxyRepositoryCreate,
-		Delete: resourceGoProxyRepositoryDelete,
-		Exists: resourceGoProxyRepositoryExists,
-		Read:   resourceGoProxyRepositoryRead,
-		Update: resourceGoProxyRepositoryUpdate,
+		Create:        resourceGoProxyRepositoryCreate,
+		Delete:        resourceGoProxyRepositoryDelete,
+		Exists:        resourceGoProxyRepositoryExists,
+		Read:          resourceGoProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceGoProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
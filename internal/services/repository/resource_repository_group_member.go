@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// repositoryGroupMemberPositions holds the member which has been put at a
+// position of a group by this provider process, keyed by
+// repositoryGroupMemberPositionKey. It is only used while holding the lock of
+// the group and only against the members read from Nexus, so entries of
+// members which have been moved or removed since do not cause conflicts.
+var repositoryGroupMemberPositions sync.Map
+
+// repositoryGroupMemberPositionKey identifies a position of a group of the
+// Nexus instance of one provider configuration
+type repositoryGroupMemberPositionKey struct {
+	meta     interface{}
+	group    string
+	position int
+}
+
+func newRepositoryGroupMemberPositionKey(m interface{}, group string, position int) repositoryGroupMemberPositionKey {
+	return repositoryGroupMemberPositionKey{
+		meta:     m,
+		group:    strings.ToLower(group),
+		position: position,
+	}
+}
+
+// claimRepositoryGroupMemberPosition fails if another member has been put at
+// the position during this run and is still there in Nexus. Otherwise the
+// position is claimed for the member. memberNames are the members read from
+// Nexus without the member itself.
+func claimRepositoryGroupMemberPosition(m interface{}, group string, member string, position int, memberNames []string) error {
+	if position < 0 {
+		return nil
+	}
+
+	key := newRepositoryGroupMemberPositionKey(m, group, position)
+	if other, ok := repositoryGroupMemberPositions.Load(key); ok && !strings.EqualFold(other.(string), member) &&
+		position < len(memberNames) && strings.EqualFold(memberNames[position], other.(string)) {
+		return fmt.Errorf("members '%s' and '%s' of group repository '%s' are both configured at position %d", other, member, group, position)
+	}
+	repositoryGroupMemberPositions.Store(key, member)
+	return nil
+}
+
+// releaseRepositoryGroupMemberPosition removes the claim of the member for the
+// position
+func releaseRepositoryGroupMemberPosition(m interface{}, group string, member string, position int) {
+	key := newRepositoryGroupMemberPositionKey(m, group, position)
+	if other, ok := repositoryGroupMemberPositions.Load(key); ok && strings.EqualFold(other.(string), member) {
+		repositoryGroupMemberPositions.Delete(key)
+	}
+}
+
+func ResourceRepositoryGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to add a single member to an existing group repository of any format.
+
+This resource is non-authoritative: other members of the group are left untouched.
+Ignore the ` + "`group`" + ` block of the resource managing the group repository via ` + "`lifecycle.ignore_changes`" + `,
+otherwise both resources change the members back and forth. Members of the same group must not be configured at the same ` + "`position`" + `,
+the apply fails if a member is put at the position another member has been put at.
+
+Group yum repositories are not supported. Nexus does not return their signing keypair, so changing their members would remove it.`,
+
+		CreateContext: resourceRepositoryGroupMemberCreate,
+		ReadContext:   resourceRepositoryGroupMemberRead,
+		UpdateContext: resourceRepositoryGroupMemberUpdate,
+		DeleteContext: resourceRepositoryGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"group": {
+				Description: "The name of the group repository to add the member to",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"member": {
+				Description: "The name of the repository to add to the group",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"position": {
+				Computed:     true,
+				Description:  "The zero-based position of the member in the group. Nexus searches the members in order. The member is appended if not set or if the position is beyond the end of the group",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+// getRepositoryGroupMemberPosition returns the configured position or -1 to
+// append the member
+func getRepositoryGroupMemberPosition(resourceData *schema.ResourceData) int {
+	if position := resourceData.GetRawConfig().GetAttr("position"); position.IsNull() {
+		return -1
+	}
+	return resourceData.Get("position").(int)
+}
+
+func resourceRepositoryGroupMemberCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	group := resourceData.Get("group").(string)
+	member := resourceData.Get("member").(string)
+	position := getRepositoryGroupMemberPosition(resourceData)
+
+	if err := modifyRepositoryGroup(ctx, client, group, func(memberNames []string) ([]string, error) {
+		if indexOfMember(memberNames, member) >= 0 {
+			return nil, fmt.Errorf("repository '%s' is already a member of group repository '%s', import it to manage it", member, group)
+		}
+		if err := claimRepositoryGroupMemberPosition(m, group, member, position, memberNames); err != nil {
+			return nil, err
+		}
+		return insertMember(memberNames, member, position), nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(repositoryGroupMemberID(group, member))
+	return resourceRepositoryGroupMemberRead(ctx, resourceData, m)
+}
+
+func resourceRepositoryGroupMemberRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	group, member, err := parseRepositoryGroupMemberID(resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	repository, err := client.GetRepositorySettings(ctx, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if repository == nil || repository.Group == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	index := indexOfMember(repository.Group.MemberNames, member)
+	if index < 0 {
+		resourceData.SetId("")
+		return nil
+	}
+
+	// A configured position beyond the end of the group appends the member,
+	// which is no drift
+	if position, ok := resourceData.GetOk("position"); !ok || index != len(repository.Group.MemberNames)-1 || position.(int) < index {
+		resourceData.Set("position", index)
+	}
+	resourceData.Set("group", repository.Name)
+	resourceData.Set("member", repository.Group.MemberNames[index])
+
+	return nil
+}
+
+func resourceRepositoryGroupMemberUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	group := resourceData.Get("group").(string)
+	member := resourceData.Get("member").(string)
+	position := getRepositoryGroupMemberPosition(resourceData)
+
+	if err := modifyRepositoryGroup(ctx, client, group, func(memberNames []string) ([]string, error) {
+		memberNames = removeMember(memberNames, member)
+		if err := claimRepositoryGroupMemberPosition(m, group, member, position, memberNames); err != nil {
+			return nil, err
+		}
+		if oldPosition, _ := resourceData.GetChange("position"); oldPosition.(int) != position {
+			releaseRepositoryGroupMemberPosition(m, group, member, oldPosition.(int))
+		}
+		return insertMember(memberNames, member, position), nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRepositoryGroupMemberRead(ctx, resourceData, m)
+}
+
+func resourceRepositoryGroupMemberDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	group := resourceData.Get("group").(string)
+	member := resourceData.Get("member").(string)
+
+	repository, err := client.GetRepositorySettings(ctx, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if repository == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := modifyRepositoryGroup(ctx, client, group, func(memberNames []string) ([]string, error) {
+		releaseRepositoryGroupMemberPosition(m, group, member, resourceData.Get("position").(int))
+		return removeMember(memberNames, member), nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId("")
+	return nil
+}
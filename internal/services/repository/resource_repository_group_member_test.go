@@ -0,0 +1,183 @@
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryGroupMemberConfig(name string, position string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "first" {
+	name = "%[1]s-first"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_repository_raw_hosted" "second" {
+	name = "%[1]s-second"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_repository_raw_group" "acceptance" {
+	name = "%[1]s"
+
+	group {
+		members = [nexus_repository_raw_hosted.first.name]
+	}
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+	}
+
+	lifecycle {
+		ignore_changes = [group]
+	}
+}
+
+resource "nexus_repository_group_member" "acceptance" {
+	group    = nexus_repository_raw_group.acceptance.name
+	member   = nexus_repository_raw_hosted.second.name
+	position = %[2]s
+}
+`, name, position)
+}
+
+func TestAccResourceRepositoryGroupMember(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	resourceName := "nexus_repository_group_member.acceptance"
+	groupResourceName := "nexus_repository_raw_group.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryGroupMemberConfig(name, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s-second", name, name)),
+					resource.TestCheckResourceAttr(resourceName, "group", name),
+					resource.TestCheckResourceAttr(resourceName, "member", name+"-second"),
+					resource.TestCheckResourceAttr(resourceName, "position", "0"),
+				),
+			},
+			{
+				// Refresh the group to see the member added by nexus_repository_group_member
+				Config: testAccResourceRepositoryGroupMemberConfig(name, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.#", "2"),
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.0", name+"-second"),
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.1", name+"-first"),
+				),
+			},
+			{
+				// Positions beyond the end of the group append the member
+				Config: testAccResourceRepositoryGroupMemberConfig(name, "5"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "position", "5"),
+				),
+			},
+			{
+				Config: testAccResourceRepositoryGroupMemberConfig(name, "5"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.0", name+"-first"),
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.1", name+"-second"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           fmt.Sprintf("%s/%s-second", name, name),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position"},
+			},
+		},
+	})
+}
+
+func TestAccResourceRepositoryGroupMemberAlreadyMember(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryGroupMemberConfig(name, "0") + `
+resource "nexus_repository_group_member" "duplicate" {
+	group  = nexus_repository_raw_group.acceptance.name
+	member = nexus_repository_raw_hosted.first.name
+}
+`,
+				ExpectError: regexp.MustCompile("is already a member of group repository"),
+			},
+		},
+	})
+}
+
+func testAccResourceRepositoryGroupMemberThirdConfig(name string, position string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "third" {
+	name = "%[1]s-third"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_repository_group_member" "third" {
+	group    = nexus_repository_raw_group.acceptance.name
+	member   = nexus_repository_raw_hosted.third.name
+	position = %[2]s
+}
+`, name, position)
+}
+
+func TestAccResourceRepositoryGroupMemberPositions(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	groupResourceName := "nexus_repository_raw_group.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryGroupMemberConfig(name, "0") + testAccResourceRepositoryGroupMemberThirdConfig(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_group_member.acceptance", "position", "0"),
+					resource.TestCheckResourceAttr("nexus_repository_group_member.third", "position", "1"),
+				),
+			},
+			{
+				// Refresh the group to see the members added by nexus_repository_group_member
+				Config: testAccResourceRepositoryGroupMemberConfig(name, "0") + testAccResourceRepositoryGroupMemberThirdConfig(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.#", "3"),
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.0", name+"-second"),
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.1", name+"-third"),
+					resource.TestCheckResourceAttr(groupResourceName, "group.0.members.2", name+"-first"),
+				),
+			},
+			{
+				Config:      testAccResourceRepositoryGroupMemberConfig(name, "0") + testAccResourceRepositoryGroupMemberThirdConfig(name, "0"),
+				ExpectError: regexp.MustCompile("are both configured at position 0"),
+			},
+		},
+	})
+}
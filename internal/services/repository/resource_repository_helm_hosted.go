@@ -13,20 +13,23 @@ func ResourceRepositoryHelmHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted helm repository.",
 
-		Create: resourceHelmHostedRepositoryCreate,
-		Delete: resourceHelmHostedRepositoryDelete,
-		Exists: resourceHelmHostedRepositoryExists,
-		Read:   resourceHelmHostedRepositoryRead,
-		Update: resourceHelmHostedRepositoryUpdate,
+		Create:        resourceHelmHostedRepositoryCreate,
+		Delete:        resourceHelmHostedRepositoryDelete,
+		Exists:        resourceHelmHostedRepositoryExists,
+		Read:          resourceHelmHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceHelmHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -13,20 +13,23 @@ func ResourceRepositoryHelmProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a helm proxy repository.",
 
-		Create: resourceHelmProxyRepositoryCreate,
-		Delete: resourceHelmProxyRepositoryDelete,
-		Exists: resourceHelmProxyRepositoryExists,
-		Read:   resourceHelmProxyRepositoryRead,
-		Update: resourceHelmProxyRepositoryUpdate,
+		Create:        resourceHelmProxyRepositoryCreate,
+		Delete:        resourceHelmProxyRepositoryDelete,
+		Exists:        resourceHelmProxyRepositoryExists,
+		Read:          resourceHelmProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceHelmProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
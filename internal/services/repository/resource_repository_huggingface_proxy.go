@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryHuggingFaceProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a Hugging Face proxy repository.",
+
+		CreateContext: resourceHuggingFaceProxyRepositoryCreate,
+		DeleteContext: resourceHuggingFaceProxyRepositoryDelete,
+		ReadContext:   resourceHuggingFaceProxyRepositoryRead,
+		UpdateContext: repositoryUpdateContext(resourceHuggingFaceProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: repositoryTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
+			// Proxy schemas
+			"cleanup":        repositorySchema.ResourceCleanup,
+			"http_client":    repositorySchema.ResourceHTTPClient,
+			"negative_cache": repositorySchema.ResourceNegativeCache,
+			"proxy":          repositorySchema.ResourceProxy,
+			"routing_rule":   repositorySchema.ResourceRoutingRule,
+			"storage":        repositorySchema.ResourceStorage,
+		},
+	}
+}
+
+func resourceHuggingFaceProxyRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo := api.HuggingFaceProxyRepository(getProxyRepositoryFromResourceData(resourceData))
+
+	if err := client.CreateHuggingFaceProxyRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return resourceHuggingFaceProxyRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceHuggingFaceProxyRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repo, err := client.GetHuggingFaceProxyRepository(ctx, resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(setProxyRepositoryToResourceData((*api.ProxyRepository)(repo), resourceData))
+}
+
+func resourceHuggingFaceProxyRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repoName := resourceData.Id()
+	repo := api.HuggingFaceProxyRepository(getProxyRepositoryFromResourceData(resourceData))
+
+	if err := client.UpdateHuggingFaceProxyRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHuggingFaceProxyRepositoryRead(ctx, resourceData, m)
+}
+
+func resourceHuggingFaceProxyRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
+}
@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryHuggingFaceProxy() api.HuggingFaceProxyRepository {
+	return api.HuggingFaceProxyRepository(testAccResourceRepositoryProxy("https://huggingface.co"))
+}
+
+func testAccResourceRepositoryHuggingFaceProxyConfig(repo api.HuggingFaceProxyRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryHuggingFaceProxyTemplate := template.Must(template.New("HuggingFaceProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryHuggingFaceProxy))
+	if err := resourceRepositoryHuggingFaceProxyTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryHuggingFaceProxy(t *testing.T) {
+	routingRule := schema.RoutingRule{
+		Name:        acctest.RandString(10),
+		Description: "acceptance test",
+		Mode:        schema.RoutingRuleModeAllow,
+		Matchers: []string{
+			"/",
+		},
+	}
+	repo := testAccResourceRepositoryHuggingFaceProxy()
+	repo.RoutingRule = &routingRule.Name
+	resourceName := "nexus_repository_huggingface_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.AccPreCheck(t)
+			acceptance.SkipIfNexusVersionBelow(t, "3.77.0")
+		},
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRoutingRuleConfig(routingRule) + testAccResourceRepositoryHuggingFaceProxyConfig(repo),
+				Check:  testAccResourceRepositoryProxyCheck(resourceName, api.ProxyRepository(repo)),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password"},
+			},
+		},
+	})
+}
@@ -12,20 +12,23 @@ func ResourceRepositoryMavenGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group maven repository.",
 
-		Create: resourceMavenGroupRepositoryCreate,
-		Delete: resourceMavenGroupRepositoryDelete,
-		Exists: resourceMavenGroupRepositoryExists,
-		Read:   resourceMavenGroupRepositoryRead,
-		Update: resourceMavenGroupRepositoryUpdate,
+		Create:        resourceMavenGroupRepositoryCreate,
+		Delete:        resourceMavenGroupRepositoryDelete,
+		Exists:        resourceMavenGroupRepositoryExists,
+		Read:          resourceMavenGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceMavenGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
@@ -35,11 +38,7 @@ func ResourceRepositoryMavenGroup() *schema.Resource {
 
 func getMavenGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.MavenGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
-	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.MavenGroupRepository{
 		Name:   resourceData.Get("name").(string),
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"text/template"
 
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func testAccResourceRepositoryMavenGroup() repository.MavenGroupRepository {
@@ -73,3 +75,84 @@ func TestAccResourceRepositoryMavenGroup(t *testing.T) {
 		},
 	})
 }
+
+// testAccReleasedProviderVersion selects a released provider version which
+// only knows the unordered member_names of group repositories
+const testAccReleasedProviderVersion = "< 2.0.0"
+
+func testAccResourceRepositoryMavenGroupMembersConfig(name string, memberAttribute string, members string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_maven_group" "acceptance" {
+	name = "%s"
+
+	group {
+		%s = [%s]
+	}
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+	}
+}
+`, name, memberAttribute, members)
+}
+
+func TestAccResourceRepositoryMavenGroupMembers(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	resourceName := "nexus_repository_maven_group.acceptance"
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"nexus": func() (*schema.Provider, error) {
+			return acceptance.TestAccProvider, nil
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acceptance.AccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// State written by a provider version without members
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"nexus": {
+						Source:            "datadrivers/nexus",
+						VersionConstraint: testAccReleasedProviderVersion,
+					},
+				},
+				Config: testAccResourceRepositoryMavenGroupMembersConfig(name, "member_names", `"maven-releases"`),
+				Check:  resource.TestCheckNoResourceAttr(resourceName, "group.0.members.#"),
+			},
+			{
+				// Switching to members in the current order does not change the group
+				ProviderFactories: providerFactories,
+				Config:            testAccResourceRepositoryMavenGroupMembersConfig(name, "members", `"maven-releases"`),
+				PlanOnly:          true,
+			},
+			{
+				ProviderFactories: providerFactories,
+				Config:            testAccResourceRepositoryMavenGroupMembersConfig(name, "members", `"maven-snapshots", "maven-releases"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.0", "maven-snapshots"),
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.1", "maven-releases"),
+				),
+			},
+			{
+				// Reordering the members in Nexus is detected
+				PreConfig: func() {
+					client := acceptance.TestAccProvider.Meta().(*nexus.NexusClient)
+					repo, err := client.Repository.Maven.Group.Get(name)
+					if err != nil {
+						t.Fatal(err)
+					}
+					repo.Group.MemberNames = []string{"maven-releases", "maven-snapshots"}
+					if err := client.Repository.Maven.Group.Update(name, *repo); err != nil {
+						t.Fatal(err)
+					}
+				},
+				ProviderFactories:  providerFactories,
+				Config:             testAccResourceRepositoryMavenGroupMembersConfig(name, "members", `"maven-snapshots", "maven-releases"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -13,20 +13,23 @@ func ResourceRepositoryMavenHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted maven repository.",
 
-		Create: resourceMavenHostedRepositoryCreate,
-		Delete: resourceMavenHostedRepositoryDelete,
-		Exists: resourceMavenHostedRepositoryExists,
-		Read:   resourceMavenHostedRepositoryRead,
-		Update: resourceMavenHostedRepositoryUpdate,
+		Create:        resourceMavenHostedRepositoryCreate,
+		Delete:        resourceMavenHostedRepositoryDelete,
+		Exists:        resourceMavenHostedRepositoryExists,
+		Read:          resourceMavenHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceMavenHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -13,20 +13,23 @@ func ResourceRepositoryMavenProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a maven proxy repository.",
 
-		Create: resourceMavenProxyRepositoryCreate,
-		Delete: resourceMavenProxyRepositoryDelete,
-		Exists: resourceMavenProxyRepositoryExists,
-		Read:   resourceMavenProxyRepositoryRead,
-		Update: resourceMavenProxyRepositoryUpdate,
+		Create:        resourceMavenProxyRepositoryCreate,
+		Delete:        resourceMavenProxyRepositoryDelete,
+		Exists:        resourceMavenProxyRepositoryExists,
+		Read:          resourceMavenProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceMavenProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
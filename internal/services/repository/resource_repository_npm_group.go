@@ -11,22 +11,25 @@ import (
 
 func ResourceRepositoryNpmGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create a group npm repository.",
-
-		Create: resourceNpmGroupRepositoryCreate,
-		Delete: resourceNpmGroupRepositoryDelete,
-		Exists: resourceNpmGroupRepositoryExists,
-		Read:   resourceNpmGroupRepositoryRead,
-		Update: resourceNpmGroupRepositoryUpdate,
+		Description: "Use this resource to create a group npm repository." + npmRepositoryDescription,
+
+		Create:        resourceNpmGroupRepositoryCreate,
+		Delete:        resourceNpmGroupRepositoryDelete,
+		Exists:        resourceNpmGroupRepositoryExists,
+		Read:          resourceNpmGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceNpmGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffGroupDeploy(repository.RepositoryFormatNPM),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroupDeploy,
 			"storage": repositorySchema.ResourceStorage,
@@ -37,10 +40,7 @@ func ResourceRepositoryNpmGroup() *schema.Resource {
 func getNpmGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.NpmGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.NpmGroupRepository{
 		Name:   resourceData.Get("name").(string),
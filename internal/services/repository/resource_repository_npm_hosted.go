@@ -9,24 +9,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// npmRepositoryDescription completes the description of the npm repository
+// resources with the npm settings Nexus does not configure per repository
+const npmRepositoryDescription = `
+
+Nexus has no repository settings for npm audit and npm login. npm audit is passed through by npm proxy and group repositories,
+npm login requires the ` + "`NpmToken`" + ` realm, which ` + "`nexus_security_realms`" + ` enables.`
+
 func ResourceRepositoryNpmHosted() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create a hosted Npm repository.",
-
-		Create: resourceNpmHostedRepositoryCreate,
-		Delete: resourceNpmHostedRepositoryDelete,
-		Exists: resourceNpmHostedRepositoryExists,
-		Read:   resourceNpmHostedRepositoryRead,
-		Update: resourceNpmHostedRepositoryUpdate,
+		Description: "Use this resource to create a hosted Npm repository." + npmRepositoryDescription,
+
+		Create:        resourceNpmHostedRepositoryCreate,
+		Delete:        resourceNpmHostedRepositoryDelete,
+		Exists:        resourceNpmHostedRepositoryExists,
+		Read:          resourceNpmHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceNpmHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
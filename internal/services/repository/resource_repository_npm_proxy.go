@@ -11,22 +11,25 @@ import (
 
 func ResourceRepositoryNpmProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create an NPM proxy repository.",
-
-		Create: resourceNpmProxyRepositoryCreate,
-		Delete: resourceNpmProxyRepositoryDelete,
-		Exists: resourceNpmProxyRepositoryExists,
-		Read:   resourceNpmProxyRepositoryRead,
-		Update: resourceNpmProxyRepositoryUpdate,
+		Description: "Use this resource to create an NPM proxy repository." + npmRepositoryDescription,
+
+		Create:        resourceNpmProxyRepositoryCreate,
+		Delete:        resourceNpmProxyRepositoryDelete,
+		Exists:        resourceNpmProxyRepositoryExists,
+		Read:          resourceNpmProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceNpmProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
@@ -12,20 +12,23 @@ func ResourceRepositoryNugetGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group nuget repository.",
 
-		Create: resourceNugetGroupRepositoryCreate,
-		Delete: resourceNugetGroupRepositoryDelete,
-		Exists: resourceNugetGroupRepositoryExists,
-		Read:   resourceNugetGroupRepositoryRead,
-		Update: resourceNugetGroupRepositoryUpdate,
+		Create:        resourceNugetGroupRepositoryCreate,
+		Delete:        resourceNugetGroupRepositoryDelete,
+		Exists:        resourceNugetGroupRepositoryExists,
+		Read:          resourceNugetGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceNugetGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
@@ -35,11 +38,7 @@ func ResourceRepositoryNugetGroup() *schema.Resource {
 
 func getNugetGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.NugetGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
-	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.NugetGroupRepository{
 		Name:   resourceData.Get("name").(string),
@@ -13,20 +13,23 @@ func ResourceRepositoryNugetHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Nuget repository.",
 
-		Create: resourceNugetHostedRepositoryCreate,
-		Delete: resourceNugetHostedRepositoryDelete,
-		Exists: resourceNugetHostedRepositoryExists,
-		Read:   resourceNugetHostedRepositoryRead,
-		Update: resourceNugetHostedRepositoryUpdate,
+		Create:        resourceNugetHostedRepositoryCreate,
+		Delete:        resourceNugetHostedRepositoryDelete,
+		Exists:        resourceNugetHostedRepositoryExists,
+		Read:          resourceNugetHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceNugetHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
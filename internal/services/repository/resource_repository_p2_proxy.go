@@ -13,20 +13,23 @@ func ResourceRepositoryP2Proxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an p2 proxy repository.",
 
-		Create: resourceP2ProxyRepositoryCreate,
-		Delete: resourceP2ProxyRepositoryDelete,
-		Exists: resourceP2ProxyRepositoryExists,
-		Read:   resourceP2ProxyRepositoryRead,
-		Update: resourceP2ProxyRepositoryUpdate,
+		Create:        resourceP2ProxyRepositoryCreate,
+		Delete:        resourceP2ProxyRepositoryDelete,
+		Exists:        resourceP2ProxyRepositoryExists,
+		Read:          resourceP2ProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceP2ProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
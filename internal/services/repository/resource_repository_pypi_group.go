@@ -1,10 +1,14 @@
 package repository
 
 import (
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"context"
+
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,51 +16,54 @@ func ResourceRepositoryPypiGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group pypi repository.",
 
-		Create: resourcePypiGroupRepositoryCreate,
-		Delete: resourcePypiGroupRepositoryDelete,
-		Exists: resourcePypiGroupRepositoryExists,
-		Read:   resourcePypiGroupRepositoryRead,
-		Update: resourcePypiGroupRepositoryUpdate,
+		CreateContext: resourcePypiGroupRepositoryCreate,
+		DeleteContext: resourcePypiGroupRepositoryDelete,
+		ReadContext:   resourcePypiGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdateContext(resourcePypiGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffGroupDeploy(repository.RepositoryFormatPyPi),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
-			"group":   repositorySchema.ResourceGroup,
+			"group":   repositorySchema.ResourceGroupDeploy,
 			"storage": repositorySchema.ResourceStorage,
 		},
 	}
 }
 
-func getPypiGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.PypiGroupRepository {
+func getPypiGroupRepositoryFromResourceData(resourceData *schema.ResourceData) api.PypiGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
-	repo := repository.PypiGroupRepository{
+	repo := api.PypiGroupRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		Group: repository.Group{
+		Group: repository.GroupDeploy{
 			MemberNames: groupMemberNames,
 		},
 	}
 
+	if groupConfig["writable_member"].(string) != "" {
+		repo.Group.WritableMember = tools.GetStringPointer(groupConfig["writable_member"].(string))
+	}
+
 	return repo
 }
 
-func setPypiGroupRepositoryToResourceData(repo *repository.PypiGroupRepository, resourceData *schema.ResourceData) error {
+func setPypiGroupRepositoryToResourceData(repo *api.PypiGroupRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -65,32 +72,32 @@ func setPypiGroupRepositoryToResourceData(repo *repository.PypiGroupRepository,
 		return err
 	}
 
-	if err := resourceData.Set("group", flattenGroup(&repo.Group)); err != nil {
+	if err := resourceData.Set("group", flattenGroupDeploy(&repo.Group)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func resourcePypiGroupRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourcePypiGroupRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repo := getPypiGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Pypi.Group.Create(repo); err != nil {
-		return err
+	if err := client.CreatePypiGroupRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourcePypiGroupRepositoryRead(resourceData, m)
+	return resourcePypiGroupRepositoryRead(ctx, resourceData, m)
 }
 
-func resourcePypiGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourcePypiGroupRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
-	repo, err := client.Repository.Pypi.Group.Get(resourceData.Id())
+	repo, err := client.GetPypiGroupRepository(ctx, resourceData.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if repo == nil {
@@ -98,30 +105,23 @@ func resourcePypiGroupRepositoryRead(resourceData *schema.ResourceData, m interf
 		return nil
 	}
 
-	return setPypiGroupRepositoryToResourceData(repo, resourceData)
+	return diag.FromErr(setPypiGroupRepositoryToResourceData(repo, resourceData))
 }
 
-func resourcePypiGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourcePypiGroupRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repoName := resourceData.Id()
 	repo := getPypiGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Pypi.Group.Update(repoName, repo); err != nil {
-		return err
+	if err := client.UpdatePypiGroupRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourcePypiGroupRepositoryRead(resourceData, m)
+	return resourcePypiGroupRepositoryRead(ctx, resourceData, m)
 }
 
-func resourcePypiGroupRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Pypi.Group.Delete(resourceData.Id())
-}
-
-func resourcePypiGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
-
-	repo, err := client.Repository.Pypi.Group.Get(resourceData.Id())
-	return repo != nil, err
+func resourcePypiGroupRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
 }
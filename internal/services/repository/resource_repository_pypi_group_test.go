@@ -3,31 +3,34 @@ package repository_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryPypiGroup() repository.PypiGroupRepository {
-	return repository.PypiGroupRepository{
+func testAccResourceRepositoryPypiGroup() api.PypiGroupRepository {
+	return api.PypiGroupRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
 			StrictContentTypeValidation: true,
 		},
-		Group: repository.Group{
+		Group: repository.GroupDeploy{
 			MemberNames: []string{},
 		},
 	}
 }
 
-func testAccResourceRepositoryPypiGroupConfig(repo repository.PypiGroupRepository) string {
+func testAccResourceRepositoryPypiGroupConfig(repo api.PypiGroupRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryPypiGroupTemplate := template.Must(template.New("PypiGroupRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryPypiGroup))
 	if err := resourceRepositoryPypiGroupTemplate.Execute(buf, repo); err != nil {
@@ -40,6 +43,13 @@ func TestAccResourceRepositoryPypiGroup(t *testing.T) {
 	repoHosted := testAccResourceRepositoryPypiHosted()
 	repo := testAccResourceRepositoryPypiGroup()
 	repo.Group.MemberNames = append(repo.Group.MemberNames, repoHosted.Name)
+
+	writableMember := ""
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "false" {
+		writableMember = repoHosted.Name
+	}
+
+	repo.Group.WritableMember = &writableMember
 	resourceName := "nexus_repository_pypi_group.acceptance"
 
 	resource.Test(t, resource.TestCase{
@@ -61,6 +71,7 @@ func TestAccResourceRepositoryPypiGroup(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "group.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.0", repo.Group.MemberNames[0]),
+						resource.TestCheckResourceAttr(resourceName, "group.0.writable_member", writableMember),
 					),
 				),
 			},
@@ -73,3 +84,37 @@ func TestAccResourceRepositoryPypiGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryPypiGroupWritableMemberValidation(t *testing.T) {
+	repoHosted := testAccResourceRepositoryPypiHosted()
+	repoProxy := testAccResourceRepositoryPypiProxy()
+	members := testAccResourceRepositoryPypiHostedConfig(repoHosted) + testAccResourceRepositoryPypiProxyConfig(repoProxy)
+
+	proxyWritable := testAccResourceRepositoryPypiGroup()
+	proxyWritable.Group.MemberNames = []string{repoHosted.Name, repoProxy.Name}
+	proxyWritable.Group.WritableMember = &repoProxy.Name
+
+	noMember := testAccResourceRepositoryPypiGroup()
+	noMember.Group.MemberNames = []string{repoProxy.Name}
+	noMember.Group.WritableMember = &repoHosted.Name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: members,
+			},
+			{
+				Config:      members + testAccResourceRepositoryPypiGroupConfig(proxyWritable),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a pypi hosted repository"),
+			},
+			{
+				Config:      members + testAccResourceRepositoryPypiGroupConfig(noMember),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a member of the group"),
+			},
+		},
+	})
+}
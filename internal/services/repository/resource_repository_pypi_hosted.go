@@ -13,20 +13,23 @@ func ResourceRepositoryPypiHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Pypi repository.",
 
-		Create: resourcePypiHostedRepositoryCreate,
-		Delete: resourcePypiHostedRepositoryDelete,
-		Exists: resourcePypiHostedRepositoryExists,
-		Read:   resourcePypiHostedRepositoryRead,
-		Update: resourcePypiHostedRepositoryUpdate,
+		Create:        resourcePypiHostedRepositoryCreate,
+		Delete:        resourcePypiHostedRepositoryDelete,
+		Exists:        resourcePypiHostedRepositoryExists,
+		Read:          resourcePypiHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourcePypiHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -13,20 +13,23 @@ func ResourceRepositoryPypiProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an NPM proxy repository.",
 
-		Create: resourcePypiProxyRepositoryCreate,
-		Delete: resourcePypiProxyRepositoryDelete,
-		Exists: resourcePypiProxyRepositoryExists,
-		Read:   resourcePypiProxyRepositoryRead,
-		Update: resourcePypiProxyRepositoryUpdate,
+		Create:        resourcePypiProxyRepositoryCreate,
+		Delete:        resourcePypiProxyRepositoryDelete,
+		Exists:        resourcePypiProxyRepositoryExists,
+		Read:          resourcePypiProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourcePypiProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
@@ -1,10 +1,14 @@
 package repository
 
 import (
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"context"
+
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,51 +16,54 @@ func ResourceRepositoryRGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group r repository.",
 
-		Create: resourceRGroupRepositoryCreate,
-		Delete: resourceRGroupRepositoryDelete,
-		Exists: resourceRGroupRepositoryExists,
-		Read:   resourceRGroupRepositoryRead,
-		Update: resourceRGroupRepositoryUpdate,
+		CreateContext: resourceRGroupRepositoryCreate,
+		DeleteContext: resourceRGroupRepositoryDelete,
+		ReadContext:   resourceRGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdateContext(resourceRGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffGroupDeploy(repositoryFormatR),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
-			"group":   repositorySchema.ResourceGroup,
+			"group":   repositorySchema.ResourceGroupDeploy,
 			"storage": repositorySchema.ResourceStorage,
 		},
 	}
 }
 
-func getRGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.RGroupRepository {
+func getRGroupRepositoryFromResourceData(resourceData *schema.ResourceData) api.RGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
-	repo := repository.RGroupRepository{
+	repo := api.RGroupRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		Group: repository.Group{
+		Group: repository.GroupDeploy{
 			MemberNames: groupMemberNames,
 		},
 	}
 
+	if groupConfig["writable_member"].(string) != "" {
+		repo.Group.WritableMember = tools.GetStringPointer(groupConfig["writable_member"].(string))
+	}
+
 	return repo
 }
 
-func setRGroupRepositoryToResourceData(repo *repository.RGroupRepository, resourceData *schema.ResourceData) error {
+func setRGroupRepositoryToResourceData(repo *api.RGroupRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -65,32 +72,32 @@ func setRGroupRepositoryToResourceData(repo *repository.RGroupRepository, resour
 		return err
 	}
 
-	if err := resourceData.Set("group", flattenGroup(&repo.Group)); err != nil {
+	if err := resourceData.Set("group", flattenGroupDeploy(&repo.Group)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func resourceRGroupRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceRGroupRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repo := getRGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.R.Group.Create(repo); err != nil {
-		return err
+	if err := client.CreateRGroupRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceRGroupRepositoryRead(resourceData, m)
+	return resourceRGroupRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceRGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceRGroupRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
-	repo, err := client.Repository.R.Group.Get(resourceData.Id())
+	repo, err := client.GetRGroupRepository(ctx, resourceData.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if repo == nil {
@@ -98,30 +105,23 @@ func resourceRGroupRepositoryRead(resourceData *schema.ResourceData, m interface
 		return nil
 	}
 
-	return setRGroupRepositoryToResourceData(repo, resourceData)
+	return diag.FromErr(setRGroupRepositoryToResourceData(repo, resourceData))
 }
 
-func resourceRGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceRGroupRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repoName := resourceData.Id()
 	repo := getRGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.R.Group.Update(repoName, repo); err != nil {
-		return err
+	if err := client.UpdateRGroupRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceRGroupRepositoryRead(resourceData, m)
+	return resourceRGroupRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceRGroupRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.R.Group.Delete(resourceData.Id())
-}
-
-func resourceRGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
-
-	repo, err := client.Repository.R.Group.Get(resourceData.Id())
-	return repo != nil, err
+func resourceRGroupRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
 }
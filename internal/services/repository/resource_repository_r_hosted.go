@@ -13,20 +13,23 @@ func ResourceRepositoryRHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted R repository.",
 
-		Create: resourceRHostedRepositoryCreate,
-		Delete: resourceRHostedRepositoryDelete,
-		Exists: resourceRHostedRepositoryExists,
-		Read:   resourceRHostedRepositoryRead,
-		Update: resourceRHostedRepositoryUpdate,
+		Create:        resourceRHostedRepositoryCreate,
+		Delete:        resourceRHostedRepositoryDelete,
+		Exists:        resourceRHostedRepositoryExists,
+		Read:          resourceRHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceRHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -12,34 +12,35 @@ func ResourceRepositoryRawGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group raw repository.",
 
-		Create: resourceRawGroupRepositoryCreate,
-		Delete: resourceRawGroupRepositoryDelete,
-		Exists: resourceRawGroupRepositoryExists,
-		Read:   resourceRawGroupRepositoryRead,
-		Update: resourceRawGroupRepositoryUpdate,
+		Create:        resourceRawGroupRepositoryCreate,
+		Delete:        resourceRawGroupRepositoryDelete,
+		Exists:        resourceRawGroupRepositoryExists,
+		Read:          resourceRawGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceRawGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
+			// Raw group schemas
+			"raw": repositorySchema.ResourceRaw,
 		},
 	}
 }
 
 func getRawGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.RawGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
-	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.RawGroupRepository{
 		Name:   resourceData.Get("name").(string),
@@ -53,6 +54,15 @@ func getRawGroupRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		},
 	}
 
+	rawList := resourceData.Get("raw").([]interface{})
+	if len(rawList) > 0 && rawList[0] != nil {
+		rawConfig := rawList[0].(map[string]interface{})
+		contentDisposition := repository.RawContentDisposition(rawConfig["content_disposition"].(string))
+		repo.Raw = &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		}
+	}
+
 	return repo
 }
 
@@ -69,6 +79,12 @@ func setRawGroupRepositoryToResourceData(repo *repository.RawGroupRepository, re
 		return err
 	}
 
+	if repo.Raw != nil {
+		if err := resourceData.Set("raw", flattenRaw(repo.Raw)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
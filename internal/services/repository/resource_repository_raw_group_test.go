@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"text/template"
 
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -14,6 +16,8 @@ import (
 )
 
 func testAccResourceRepositoryRawGroup() repository.RawGroupRepository {
+	contentDisposition := repository.RawContentDispositionAttachment
+
 	return repository.RawGroupRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
@@ -24,6 +28,9 @@ func testAccResourceRepositoryRawGroup() repository.RawGroupRepository {
 		Group: repository.Group{
 			MemberNames: []string{},
 		},
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
 	}
 }
 
@@ -61,6 +68,8 @@ func TestAccResourceRepositoryRawGroup(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "group.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.0", repo.Group.MemberNames[0]),
+						resource.TestCheckResourceAttr(resourceName, "raw.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "raw.0.content_disposition", string(*repo.Raw.ContentDisposition)),
 					),
 				),
 			},
@@ -73,3 +82,110 @@ func TestAccResourceRepositoryRawGroup(t *testing.T) {
 		},
 	})
 }
+
+func testAccResourceRepositoryRawGroupMembersConfig(name string, memberAttribute string, members []string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "first" {
+	name = "%[1]s-first"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_repository_raw_hosted" "second" {
+	name = "%[1]s-second"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_repository_raw_group" "acceptance" {
+	name = "%[1]s"
+
+	group {
+		%[2]s = [%[3]s]
+	}
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+	}
+
+	depends_on = [
+		nexus_repository_raw_hosted.first,
+		nexus_repository_raw_hosted.second,
+	]
+}
+`, name, memberAttribute, "\""+strings.Join(members, "\", \"")+"\"")
+}
+
+func TestAccResourceRepositoryRawGroupMembersOrder(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	first := name + "-first"
+	second := name + "-second"
+	resourceName := "nexus_repository_raw_group.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Groups created with member_names can switch to members without changes
+				Config: testAccResourceRepositoryRawGroupMembersConfig(name, "member_names", []string{first}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "group.0.member_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.0", first),
+				),
+			},
+			{
+				Config:   testAccResourceRepositoryRawGroupMembersConfig(name, "members", []string{first}),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccResourceRepositoryRawGroupMembersConfig(name, "members", []string{second, first}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.0", second),
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.1", first),
+				),
+			},
+			{
+				// Reordering the members outside of Terraform is detected
+				PreConfig: func() {
+					client := acceptance.TestAccProvider.Meta().(*nexus.NexusClient)
+					repo, err := client.Repository.Raw.Group.Get(name)
+					if err != nil {
+						t.Fatal(err)
+					}
+					repo.Group.MemberNames = []string{first, second}
+					if err := client.Repository.Raw.Group.Update(name, *repo); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccResourceRepositoryRawGroupMembersConfig(name, "members", []string{second, first}),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceRepositoryRawGroupMembersConfig(name, "members", []string{second, first}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.0", second),
+					resource.TestCheckResourceAttr(resourceName, "group.0.members.1", first),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
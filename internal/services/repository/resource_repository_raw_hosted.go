@@ -13,24 +13,29 @@ func ResourceRepositoryRawHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted raw repository.",
 
-		Create: resourceRawHostedRepositoryCreate,
-		Delete: resourceRawHostedRepositoryDelete,
-		Exists: resourceRawHostedRepositoryExists,
-		Read:   resourceRawHostedRepositoryRead,
-		Update: resourceRawHostedRepositoryUpdate,
+		Create:        resourceRawHostedRepositoryCreate,
+		Delete:        resourceRawHostedRepositoryDelete,
+		Exists:        resourceRawHostedRepositoryExists,
+		Read:          resourceRawHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceRawHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
 			"storage":   repositorySchema.ResourceHostedStorage,
+			// Raw hosted schemas
+			"raw": repositorySchema.ResourceRaw,
 		},
 	}
 }
@@ -72,6 +77,15 @@ func getRawHostedRepositoryFromResourceData(resourceData *schema.ResourceData) r
 		}
 	}
 
+	rawList := resourceData.Get("raw").([]interface{})
+	if len(rawList) > 0 && rawList[0] != nil {
+		rawConfig := rawList[0].(map[string]interface{})
+		contentDisposition := repository.RawContentDisposition(rawConfig["content_disposition"].(string))
+		repo.Raw = &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		}
+	}
+
 	return repo
 }
 
@@ -96,6 +110,12 @@ func setRawHostedRepositoryToResourceData(repo *repository.RawHostedRepository,
 		}
 	}
 
+	if repo.Raw != nil {
+		if err := resourceData.Set("raw", flattenRaw(repo.Raw)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
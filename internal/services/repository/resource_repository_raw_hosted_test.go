@@ -3,18 +3,21 @@ package repository_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func testAccResourceRepositoryRawHosted() repository.RawHostedRepository {
 	writePolicy := repository.StorageWritePolicyAllow
+	contentDisposition := repository.RawContentDispositionInline
 
 	return repository.RawHostedRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
@@ -30,6 +33,9 @@ func testAccResourceRepositoryRawHosted() repository.RawHostedRepository {
 		Component: &repository.Component{
 			ProprietaryComponents: true,
 		},
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
 	}
 }
 
@@ -68,6 +74,8 @@ func TestAccResourceRepositoryRawHosted(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
 						resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "component.0.proprietary_components", strconv.FormatBool(repo.Component.ProprietaryComponents)),
+						resource.TestCheckResourceAttr(resourceName, "raw.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "raw.0.content_disposition", string(*repo.Raw.ContentDisposition)),
 					),
 				),
 			},
@@ -80,3 +88,37 @@ func TestAccResourceRepositoryRawHosted(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryRawHostedBlobStoreChange(t *testing.T) {
+	repo := testAccResourceRepositoryRawHosted()
+	resourceName := "nexus_repository_raw_hosted.acceptance"
+
+	blobStoreName := fmt.Sprintf("test-blobstore-%s", acctest.RandString(5))
+	blobStoreConfig := fmt.Sprintf(`
+resource "nexus_blobstore_file" "target" {
+	name = "%s"
+}`, blobStoreName)
+	moved := repo
+	moved.Storage.BlobStoreName = blobStoreName
+
+	changeStep := resource.TestStep{
+		Config: blobStoreConfig + testAccResourceRepositoryRawHostedConfig(moved),
+		Check:  resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", blobStoreName),
+	}
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		changeStep.Check = nil
+		changeStep.ExpectError = regexp.MustCompile("requires Nexus Pro")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: blobStoreConfig + testAccResourceRepositoryRawHostedConfig(repo),
+				Check:  resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+			},
+			changeStep,
+		},
+	})
+}
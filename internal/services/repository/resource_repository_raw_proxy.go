@@ -13,20 +13,23 @@ func ResourceRepositoryRawProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a raw proxy repository.",
 
-		Create: resourceRawProxyRepositoryCreate,
-		Delete: resourceRawProxyRepositoryDelete,
-		Exists: resourceRawProxyRepositoryExists,
-		Read:   resourceRawProxyRepositoryRead,
-		Update: resourceRawProxyRepositoryUpdate,
+		Create:        resourceRawProxyRepositoryCreate,
+		Delete:        resourceRawProxyRepositoryDelete,
+		Exists:        resourceRawProxyRepositoryExists,
+		Read:          resourceRawProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceRawProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
@@ -34,6 +37,8 @@ func ResourceRepositoryRawProxy() *schema.Resource {
 			"proxy":          repositorySchema.ResourceProxy,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
+			// Raw proxy schemas
+			"raw": repositorySchema.ResourceRaw,
 		},
 	}
 }
@@ -114,6 +119,15 @@ func getRawProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		}
 	}
 
+	rawList := resourceData.Get("raw").([]interface{})
+	if len(rawList) > 0 && rawList[0] != nil {
+		rawConfig := rawList[0].(map[string]interface{})
+		contentDisposition := repository.RawContentDisposition(rawConfig["content_disposition"].(string))
+		repo.Raw = &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		}
+	}
+
 	return repo
 }
 
@@ -149,6 +163,12 @@ func setRawProxyRepositoryToResourceData(repo *repository.RawProxyRepository, re
 			return err
 		}
 	}
+
+	if repo.Raw != nil {
+		if err := resourceData.Set("raw", flattenRaw(repo.Raw)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
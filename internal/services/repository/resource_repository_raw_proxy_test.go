@@ -20,6 +20,7 @@ func testAccResourceRepositoryRawProxy() repository.RawProxyRepository {
 	retries := 3
 	timeout := 15
 	useTrustStore := true
+	contentDisposition := repository.RawContentDispositionInline
 
 	return repository.RawProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
@@ -57,6 +58,9 @@ func testAccResourceRepositoryRawProxy() repository.RawProxyRepository {
 			MetadataMaxAge: 770,
 			RemoteURL:      "https://raw.elastic.co",
 		},
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
 	}
 }
 
@@ -123,6 +127,8 @@ func TestAccResourceRepositoryRawProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
 						resource.TestCheckResourceAttr(resourceName, "routing_rule", *repo.RoutingRule),
+						resource.TestCheckResourceAttr(resourceName, "raw.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "raw.0.content_disposition", string(*repo.Raw.ContentDisposition)),
 					),
 				),
 			},
@@ -1,10 +1,14 @@
 package repository
 
 import (
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"context"
+
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,51 +16,54 @@ func ResourceRepositoryRubygemsGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group rubygems repository.",
 
-		Create: resourceRubygemsGroupRepositoryCreate,
-		Delete: resourceRubygemsGroupRepositoryDelete,
-		Exists: resourceRubygemsGroupRepositoryExists,
-		Read:   resourceRubygemsGroupRepositoryRead,
-		Update: resourceRubygemsGroupRepositoryUpdate,
+		CreateContext: resourceRubygemsGroupRepositoryCreate,
+		DeleteContext: resourceRubygemsGroupRepositoryDelete,
+		ReadContext:   resourceRubygemsGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdateContext(resourceRubygemsGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffGroupDeploy(repository.RepositoryFormatRuby),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
-			"group":   repositorySchema.ResourceGroup,
+			"group":   repositorySchema.ResourceGroupDeploy,
 			"storage": repositorySchema.ResourceStorage,
 		},
 	}
 }
 
-func getRubygemsGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.RubyGemsGroupRepository {
+func getRubygemsGroupRepositoryFromResourceData(resourceData *schema.ResourceData) api.RubyGemsGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
-	repo := repository.RubyGemsGroupRepository{
+	repo := api.RubyGemsGroupRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		Group: repository.Group{
+		Group: repository.GroupDeploy{
 			MemberNames: groupMemberNames,
 		},
 	}
 
+	if groupConfig["writable_member"].(string) != "" {
+		repo.Group.WritableMember = tools.GetStringPointer(groupConfig["writable_member"].(string))
+	}
+
 	return repo
 }
 
-func setRubygemsGroupRepositoryToResourceData(repo *repository.RubyGemsGroupRepository, resourceData *schema.ResourceData) error {
+func setRubygemsGroupRepositoryToResourceData(repo *api.RubyGemsGroupRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -65,32 +72,32 @@ func setRubygemsGroupRepositoryToResourceData(repo *repository.RubyGemsGroupRepo
 		return err
 	}
 
-	if err := resourceData.Set("group", flattenGroup(&repo.Group)); err != nil {
+	if err := resourceData.Set("group", flattenGroupDeploy(&repo.Group)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func resourceRubygemsGroupRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceRubygemsGroupRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repo := getRubygemsGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.RubyGems.Group.Create(repo); err != nil {
-		return err
+	if err := client.CreateRubyGemsGroupRepository(ctx, repo); err != nil {
+		return diag.FromErr(err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceRubygemsGroupRepositoryRead(resourceData, m)
+	return resourceRubygemsGroupRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceRubygemsGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceRubygemsGroupRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
-	repo, err := client.Repository.RubyGems.Group.Get(resourceData.Id())
+	repo, err := client.GetRubyGemsGroupRepository(ctx, resourceData.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if repo == nil {
@@ -98,30 +105,23 @@ func resourceRubygemsGroupRepositoryRead(resourceData *schema.ResourceData, m in
 		return nil
 	}
 
-	return setRubygemsGroupRepositoryToResourceData(repo, resourceData)
+	return diag.FromErr(setRubygemsGroupRepositoryToResourceData(repo, resourceData))
 }
 
-func resourceRubygemsGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+func resourceRubygemsGroupRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
 
 	repoName := resourceData.Id()
 	repo := getRubygemsGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.RubyGems.Group.Update(repoName, repo); err != nil {
-		return err
+	if err := client.UpdateRubyGemsGroupRepository(ctx, repoName, repo); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceRubygemsGroupRepositoryRead(resourceData, m)
+	return resourceRubygemsGroupRepositoryRead(ctx, resourceData, m)
 }
 
-func resourceRubygemsGroupRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.RubyGems.Group.Delete(resourceData.Id())
-}
-
-func resourceRubygemsGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
-
-	repo, err := client.Repository.RubyGems.Group.Get(resourceData.Id())
-	return repo != nil, err
+func resourceRubygemsGroupRepositoryDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	return diag.FromErr(client.DeleteRepository(ctx, resourceData.Id()))
 }
@@ -3,31 +3,34 @@ package repository_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryRubygemsGroup() repository.RubyGemsGroupRepository {
-	return repository.RubyGemsGroupRepository{
+func testAccResourceRepositoryRubygemsGroup() api.RubyGemsGroupRepository {
+	return api.RubyGemsGroupRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
 			StrictContentTypeValidation: true,
 		},
-		Group: repository.Group{
+		Group: repository.GroupDeploy{
 			MemberNames: []string{},
 		},
 	}
 }
 
-func testAccResourceRepositoryRubygemsGroupConfig(repo repository.RubyGemsGroupRepository) string {
+func testAccResourceRepositoryRubygemsGroupConfig(repo api.RubyGemsGroupRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryRubygemsGroupTemplate := template.Must(template.New("RubygemsGroupRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryRubygemsGroup))
 	if err := resourceRepositoryRubygemsGroupTemplate.Execute(buf, repo); err != nil {
@@ -40,6 +43,13 @@ func TestAccResourceRepositoryRubygemsGroup(t *testing.T) {
 	repoHosted := testAccResourceRepositoryRubygemsHosted()
 	repo := testAccResourceRepositoryRubygemsGroup()
 	repo.Group.MemberNames = append(repo.Group.MemberNames, repoHosted.Name)
+
+	writableMember := ""
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "false" {
+		writableMember = repoHosted.Name
+	}
+
+	repo.Group.WritableMember = &writableMember
 	resourceName := "nexus_repository_rubygems_group.acceptance"
 
 	resource.Test(t, resource.TestCase{
@@ -61,6 +71,7 @@ func TestAccResourceRepositoryRubygemsGroup(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "group.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.0", repo.Group.MemberNames[0]),
+						resource.TestCheckResourceAttr(resourceName, "group.0.writable_member", writableMember),
 					),
 				),
 			},
@@ -73,3 +84,37 @@ func TestAccResourceRepositoryRubygemsGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryRubygemsGroupWritableMemberValidation(t *testing.T) {
+	repoHosted := testAccResourceRepositoryRubygemsHosted()
+	repoProxy := testAccResourceRepositoryRubygemsProxy()
+	members := testAccResourceRepositoryRubygemsHostedConfig(repoHosted) + testAccResourceRepositoryRubygemsProxyConfig(repoProxy)
+
+	proxyWritable := testAccResourceRepositoryRubygemsGroup()
+	proxyWritable.Group.MemberNames = []string{repoHosted.Name, repoProxy.Name}
+	proxyWritable.Group.WritableMember = &repoProxy.Name
+
+	noMember := testAccResourceRepositoryRubygemsGroup()
+	noMember.Group.MemberNames = []string{repoProxy.Name}
+	noMember.Group.WritableMember = &repoHosted.Name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: members,
+			},
+			{
+				Config:      members + testAccResourceRepositoryRubygemsGroupConfig(proxyWritable),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a rubygems hosted repository"),
+			},
+			{
+				Config:      members + testAccResourceRepositoryRubygemsGroupConfig(noMember),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a member of the group"),
+			},
+		},
+	})
+}
@@ -13,20 +13,23 @@ func ResourceRepositoryRubygemsHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Rubygems repository.",
 
-		Create: resourceRubygemsHostedRepositoryCreate,
-		Delete: resourceRubygemsHostedRepositoryDelete,
-		Exists: resourceRubygemsHostedRepositoryExists,
-		Read:   resourceRubygemsHostedRepositoryRead,
-		Update: resourceRubygemsHostedRepositoryUpdate,
+		Create:        resourceRubygemsHostedRepositoryCreate,
+		Delete:        resourceRubygemsHostedRepositoryDelete,
+		Exists:        resourceRubygemsHostedRepositoryExists,
+		Read:          resourceRubygemsHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceRubygemsHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
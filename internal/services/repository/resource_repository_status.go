@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to control only whether a repository is online and, for proxy repositories, whether it is blocked.
+
+Set ` + "`ignore_status_changes = true`" + ` on the resource managing the repository, so both resources do not fight over these settings.
+Destroying this resource does not change the repository.
+
+Proxy repositories with authentication, hosted apt and group yum repositories are not supported. Nexus does not return their password, token or signing keypair, so changing their status would remove them.`,
+
+		CreateContext: resourceRepositoryStatusUpdate,
+		ReadContext:   resourceRepositoryStatusRead,
+		UpdateContext: resourceRepositoryStatusUpdate,
+		DeleteContext: resourceRepositoryStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "The name of the repository",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"online": {
+				Default:     true,
+				Description: "Whether the repository accepts incoming requests",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"blocked": {
+				Computed:    true,
+				Description: "Whether to block outbound connections of the proxy repository. Only supported for proxy repositories, left unchanged if not set",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"auto_block": {
+				Computed:    true,
+				Description: "Whether to auto-block outbound connections of the proxy repository if the remote peer is detected as unreachable/unresponsive. Only supported for proxy repositories, left unchanged if not set",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"format": {
+				Computed:    true,
+				Description: "The format of the repository",
+				Type:        schema.TypeString,
+			},
+			"type": {
+				Computed:    true,
+				Description: "The type of the repository, `hosted`, `proxy` or `group`",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceRepositoryStatusUpdate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	name := resourceData.Get("name").(string)
+
+	repository, err := client.GetRepositorySettings(ctx, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if repository == nil {
+		return diag.Errorf("repository %q does not exist", name)
+	}
+
+	status := api.RepositoryStatus{
+		Online: resourceData.Get("online").(bool),
+	}
+	config := resourceData.GetRawConfig()
+	if blocked := config.GetAttr("blocked"); blocked.IsKnown() && !blocked.IsNull() {
+		value := blocked.True()
+		status.Blocked = &value
+	}
+	if autoBlock := config.GetAttr("auto_block"); autoBlock.IsKnown() && !autoBlock.IsNull() {
+		value := autoBlock.True()
+		status.AutoBlock = &value
+	}
+	if err := client.SetRepositoryStatus(ctx, *repository, status); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceData.SetId(name)
+
+	return resourceRepositoryStatusRead(ctx, resourceData, m)
+}
+
+func resourceRepositoryStatusRead(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	repository, err := client.GetRepositorySettings(ctx, resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if repository == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	resourceData.Set("name", repository.Name)
+	resourceData.Set("online", repository.Online)
+	resourceData.Set("format", repository.Format)
+	resourceData.Set("type", repository.Type)
+	if repository.HTTPClient != nil {
+		resourceData.Set("blocked", repository.HTTPClient.Blocked)
+		resourceData.Set("auto_block", repository.HTTPClient.AutoBlock)
+	}
+
+	return nil
+}
+
+func resourceRepositoryStatusDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceData.SetId("")
+	return nil
+}
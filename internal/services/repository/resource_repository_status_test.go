@@ -0,0 +1,175 @@
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryStatusConfig(name string, online bool, blocked bool) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_proxy" "acceptance" {
+	name                  = "%[1]s"
+	ignore_status_changes = true
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+	}
+
+	proxy {
+		remote_url       = "https://raw.elastic.co"
+		content_max_age  = 1440
+		metadata_max_age = 1440
+	}
+
+	negative_cache {
+		enabled = true
+		ttl     = 1440
+	}
+
+	http_client {
+		blocked    = false
+		auto_block = true
+	}
+}
+
+resource "nexus_repository_status" "acceptance" {
+	name    = nexus_repository_raw_proxy.acceptance.name
+	online  = %[2]t
+	blocked = %[3]t
+}
+`, name, online, blocked)
+}
+
+func TestAccResourceRepositoryStatus(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	resourceName := "nexus_repository_status.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryStatusConfig(name, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "online", "false"),
+					resource.TestCheckResourceAttr(resourceName, "blocked", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_block", "true"),
+					resource.TestCheckResourceAttr(resourceName, "format", "raw"),
+					resource.TestCheckResourceAttr(resourceName, "type", "proxy"),
+				),
+			},
+			{
+				// The repository resource ignores the status set by nexus_repository_status
+				Config:             testAccResourceRepositoryStatusConfig(name, false, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccResourceRepositoryStatusConfig(name, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "online", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blocked", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceRepositoryStatusBlockedNoProxy(t *testing.T) {
+	repo := testAccResourceRepositoryRawHosted()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryRawHostedConfig(repo) + `
+resource "nexus_repository_status" "acceptance" {
+	name    = nexus_repository_raw_hosted.acceptance.name
+	blocked = true
+}
+`,
+				ExpectError: regexp.MustCompile("is no proxy repository"),
+			},
+		},
+	})
+}
+
+func testAccResourceRepositoryStatusAuthenticationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_proxy" "acceptance" {
+	name = "%s"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+	}
+
+	proxy {
+		remote_url       = "https://raw.elastic.co"
+		content_max_age  = 1440
+		metadata_max_age = 1440
+	}
+
+	negative_cache {
+		enabled = true
+		ttl     = 1440
+	}
+
+	http_client {
+		blocked    = false
+		auto_block = true
+
+		authentication {
+			type     = "username"
+			username = "acceptance"
+			password = "acceptance"
+		}
+	}
+}
+`, name)
+}
+
+func TestAccResourceRepositoryStatusAuthentication(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	resourceName := "nexus_repository_raw_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryStatusAuthenticationConfig(name) + `
+resource "nexus_repository_status" "acceptance" {
+	name   = nexus_repository_raw_proxy.acceptance.name
+	online = false
+}
+`,
+				ExpectError: regexp.MustCompile("without removing the credentials"),
+			},
+			{
+				// The credentials and the status of the repository are unchanged
+				Config: testAccResourceRepositoryStatusAuthenticationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "online", "true"),
+					resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.0.type", "username"),
+					resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.0.username", "acceptance"),
+				),
+			},
+		},
+	})
+}
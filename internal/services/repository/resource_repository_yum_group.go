@@ -13,36 +13,37 @@ func ResourceRepositoryYumGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group yum repository.",
 
-		Create: resourceYumGroupRepositoryCreate,
-		Delete: resourceYumGroupRepositoryDelete,
-		Exists: resourceYumGroupRepositoryExists,
-		Read:   resourceYumGroupRepositoryRead,
-		Update: resourceYumGroupRepositoryUpdate,
+		Create:        resourceYumGroupRepositoryCreate,
+		Delete:        resourceYumGroupRepositoryDelete,
+		Exists:        resourceYumGroupRepositoryExists,
+		Read:          resourceYumGroupRepositoryRead,
+		UpdateContext: repositoryGroupUpdate(resourceYumGroupRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffSigningKey("yum_signing"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
 			// Yum group schemas
-			"yum_signing": repositorySchema.ResourceYumSigning,
+			"yum_signing":             repositorySchema.ResourceYumSigning,
+			"signing_key_fingerprint": repositorySchema.ResourceSigningKeyFingerprint,
+			"signing_key_id":          repositorySchema.ResourceSigningKeyID,
 		},
 	}
 }
 
 func getYumGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.YumGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
-	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
-	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
-		groupMemberNames = append(groupMemberNames, name.(string))
-	}
+	groupMemberNames := getGroupMemberNames(resourceData)
 
 	repo := repository.YumGroupRepository{
 		Name:   resourceData.Get("name").(string),
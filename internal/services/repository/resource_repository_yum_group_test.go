@@ -19,8 +19,8 @@ func testAccResourceRepositoryYumGroup() repository.YumGroupRepository {
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		YumSigning: &repository.YumSigning{
-			Keypair:    tools.GetStringPointer("test-keypair"),
-			Passphrase: tools.GetStringPointer("test-keypair"),
+			Keypair:    tools.GetStringPointer(acceptance.TestPGPKeypair),
+			Passphrase: tools.GetStringPointer(acceptance.TestPGPPassphrase),
 		},
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
@@ -71,6 +71,8 @@ func TestAccResourceRepositoryYumGroup(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "yum_signing.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "yum_signing.0.keypair", *repo.YumSigning.Keypair),
 						resource.TestCheckResourceAttr(resourceName, "yum_signing.0.passphrase", *repo.YumSigning.Passphrase),
+						resource.TestCheckResourceAttr(resourceName, "signing_key_fingerprint", acceptance.TestPGPFingerprint),
+						resource.TestCheckResourceAttr(resourceName, "signing_key_id", acceptance.TestPGPKeyID),
 					),
 				),
 			},
@@ -79,7 +81,7 @@ func TestAccResourceRepositoryYumGroup(t *testing.T) {
 				ImportStateId:           repo.Name,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"yum_signing"},
+				ImportStateVerifyIgnore: []string{"yum_signing", "signing_key_fingerprint", "signing_key_id"},
 			},
 		},
 	})
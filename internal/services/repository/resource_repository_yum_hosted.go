@@ -14,20 +14,23 @@ func ResourceRepositoryYumHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted yum repository.",
 
-		Create: resourceYumHostedRepositoryCreate,
-		Delete: resourceYumHostedRepositoryDelete,
-		Exists: resourceYumHostedRepositoryExists,
-		Read:   resourceYumHostedRepositoryRead,
-		Update: resourceYumHostedRepositoryUpdate,
+		Create:        resourceYumHostedRepositoryCreate,
+		Delete:        resourceYumHostedRepositoryDelete,
+		Exists:        resourceYumHostedRepositoryExists,
+		Read:          resourceYumHostedRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceYumHostedRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffBlobStoreChange,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -13,20 +13,23 @@ func ResourceRepositoryYumProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a yum proxy repository.",
 
-		Create: resourceYumProxyRepositoryCreate,
-		Delete: resourceYumProxyRepositoryDelete,
-		Exists: resourceYumProxyRepositoryExists,
-		Read:   resourceYumProxyRepositoryRead,
-		Update: resourceYumProxyRepositoryUpdate,
+		Create:        resourceYumProxyRepositoryCreate,
+		Delete:        resourceYumProxyRepositoryDelete,
+		Exists:        resourceYumProxyRepositoryExists,
+		Read:          resourceYumProxyRepositoryRead,
+		UpdateContext: repositoryUpdate(resourceYumProxyRepositoryUpdate),
+		CustomizeDiff: repositoryCustomizeDiffSigningKey("yum_signing"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: repositoryTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                    common.ResourceID,
+			"name":                  repositorySchema.ResourceName,
+			"online":                repositorySchema.ResourceOnline,
+			"ignore_status_changes": repositorySchema.ResourceIgnoreStatusChanges,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
@@ -35,7 +38,9 @@ func ResourceRepositoryYumProxy() *schema.Resource {
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 			// Yum proxy schemas
-			"yum_signing": repositorySchema.ResourceYumSigning,
+			"yum_signing":             repositorySchema.ResourceYumSigning,
+			"signing_key_fingerprint": repositorySchema.ResourceSigningKeyFingerprint,
+			"signing_key_id":          repositorySchema.ResourceSigningKeyID,
 		},
 	}
 }
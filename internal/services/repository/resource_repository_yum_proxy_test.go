@@ -26,8 +26,8 @@ func testAccResourceRepositoryYumProxy() repository.YumProxyRepository {
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		YumSigning: &repository.YumSigning{
-			Keypair:    tools.GetStringPointer("test-keypair"),
-			Passphrase: tools.GetStringPointer("test-keypair"),
+			Keypair:    tools.GetStringPointer(acceptance.TestPGPKeypair),
+			Passphrase: tools.GetStringPointer(acceptance.TestPGPPassphrase),
 		},
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
@@ -133,6 +133,8 @@ func TestAccResourceRepositoryYumProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "yum_signing.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "yum_signing.0.keypair", *repo.YumSigning.Keypair),
 						resource.TestCheckResourceAttr(resourceName, "yum_signing.0.passphrase", *repo.YumSigning.Passphrase),
+						resource.TestCheckResourceAttr(resourceName, "signing_key_fingerprint", acceptance.TestPGPFingerprint),
+						resource.TestCheckResourceAttr(resourceName, "signing_key_id", acceptance.TestPGPKeyID),
 					),
 				),
 			},
@@ -141,7 +143,7 @@ func TestAccResourceRepositoryYumProxy(t *testing.T) {
 				ImportStateId:           repo.Name,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password", "yum_signing"},
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password", "yum_signing", "signing_key_fingerprint", "signing_key_id"},
 			},
 		},
 	})
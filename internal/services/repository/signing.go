@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// repositoryCustomizeDiffSigningKey returns the CustomizeDiffFunc of
+// repositories signing their metadata with the PGP key pair of the given
+// signing block. The fingerprint and key ID are computed from the configured
+// key pair to make a changed configuration visible in the plan. Nexus never
+// returns the key pair, so they do not reflect the key pair used by Nexus and
+// a key pair changed outside of Terraform is not detected. A passphrase, which
+// does not decrypt the key pair, fails the plan.
+func repositoryCustomizeDiffSigningKey(signingAttribute string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		if err := setSigningKeyDiff(diff, signingAttribute); err != nil {
+			return err
+		}
+		return repositoryCustomizeDiffBlobStoreChange(ctx, diff, m)
+	}
+}
+
+func setSigningKeyDiff(diff *schema.ResourceDiff, signingAttribute string) error {
+	keypairAttribute := fmt.Sprintf("%s.0.keypair", signingAttribute)
+	passphraseAttribute := fmt.Sprintf("%s.0.passphrase", signingAttribute)
+
+	if !diff.NewValueKnown(keypairAttribute) || !diff.NewValueKnown(passphraseAttribute) {
+		if err := diff.SetNewComputed("signing_key_fingerprint"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("signing_key_id")
+	}
+
+	fingerprint, keyID := "", ""
+	if keypair := diff.Get(keypairAttribute).(string); keypair != "" {
+		key, err := tools.GetPGPKey(keypair, diff.Get(passphraseAttribute).(string))
+		if err != nil {
+			return fmt.Errorf("%s: %v", keypairAttribute, err)
+		}
+		fingerprint, keyID = key.Fingerprint, key.KeyID
+	}
+
+	if err := diff.SetNew("signing_key_fingerprint", fingerprint); err != nil {
+		return err
+	}
+	return diff.SetNew("signing_key_id", keyID)
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// repositoryUpdate wraps the update function of a repository resource.
+// Nexus refuses to change the blob store of a repository with its update, so a
+// changed storage.0.blob_store_name is applied by the Nexus Pro task
+// "Admin - Change repository blob store" first, within the update timeout of
+// the resource. With ignore_status_changes the online and blocking state of
+// the repository is kept as it is in Nexus.
+func repositoryUpdate(update schema.UpdateFunc) schema.UpdateContextFunc {
+	return repositoryUpdateContext(func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(update(resourceData, m))
+	})
+}
+
+// repositoryUpdateContext is repositoryUpdate for resources with context aware
+// functions
+func repositoryUpdateContext(update schema.UpdateContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := api.NewClient(m)
+
+		if resourceData.HasChange("storage.0.blob_store_name") {
+			oldName, newName := resourceData.GetChange("storage.0.blob_store_name")
+			if err := changeRepositoryBlobStore(ctx, client, resourceData.Id(), oldName.(string), newName.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if resourceData.Get("ignore_status_changes").(bool) {
+			if err := keepRepositoryStatus(ctx, client, resourceData); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return update(ctx, resourceData, m)
+	}
+}
+
+// keepRepositoryStatus sets the online and blocking state of the repository in
+// Nexus to the resource data, so the update does not change them
+func keepRepositoryStatus(ctx context.Context, client *api.Client, resourceData *schema.ResourceData) error {
+	settings, err := client.GetRepositorySettings(ctx, resourceData.Id())
+	if err != nil || settings == nil {
+		return err
+	}
+
+	if err := resourceData.Set("online", settings.Online); err != nil {
+		return err
+	}
+
+	httpClientList, ok := resourceData.Get("http_client").([]interface{})
+	if !ok || len(httpClientList) == 0 || httpClientList[0] == nil || settings.HTTPClient == nil {
+		return nil
+	}
+	httpClient := httpClientList[0].(map[string]interface{})
+	httpClient["blocked"] = settings.HTTPClient.Blocked
+	httpClient["auto_block"] = settings.HTTPClient.AutoBlock
+
+	return resourceData.Set("http_client", httpClientList)
+}
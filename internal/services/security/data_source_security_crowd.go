@@ -0,0 +1,51 @@
+package security
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityCrowd() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this data source to get the Atlassian Crowd configuration.`,
+
+		ReadContext: dataSourceSecurityCrowdRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"application_name": {
+				Computed:    true,
+				Description: "The name of the application configured in Crowd",
+				Type:        schema.TypeString,
+			},
+			"enabled": {
+				Computed:    true,
+				Description: "Whether Crowd support is enabled",
+				Type:        schema.TypeBool,
+			},
+			"timeout": {
+				Computed:    true,
+				Description: "Timeout in milliseconds for requests to Crowd",
+				Type:        schema.TypeInt,
+			},
+			"url": {
+				Computed:    true,
+				Description: "The URL of the Crowd server",
+				Type:        schema.TypeString,
+			},
+			"use_trust_store": {
+				Computed:    true,
+				Description: "Whether to use certificates stored in Nexus Repository Manager's truststore for the connection to Crowd",
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityCrowdRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceSecurityCrowdRead(ctx, d, m)
+}
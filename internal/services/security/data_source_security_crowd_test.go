@@ -0,0 +1,47 @@
+package security_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityCrowd(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resName := "data.nexus_security_crowd.acceptance"
+	crowd := testAccResourceSecurityCrowd()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityCrowdConfig(crowd),
+				Check:  nil,
+			},
+			{
+				Config: testAccResourceSecurityCrowdConfig(crowd) + testAccDataSourceSecurityCrowdConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "application_name", crowd.ApplicationName),
+					resource.TestCheckResourceAttr(resName, "enabled", strconv.FormatBool(crowd.Enabled)),
+					resource.TestCheckResourceAttr(resName, "timeout", strconv.Itoa(crowd.Timeout)),
+					resource.TestCheckResourceAttr(resName, "url", crowd.URL),
+					resource.TestCheckResourceAttr(resName, "use_trust_store", strconv.FormatBool(crowd.UseTrustStoreForURL)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityCrowdConfig() string {
+	return `
+data "nexus_security_crowd" "acceptance" {
+}
+`
+}
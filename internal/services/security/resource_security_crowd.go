@@ -0,0 +1,151 @@
+package security
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSecurityCrowd() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to configure the connection to Atlassian Crowd.
+
+The "Crowd" realm still needs to be activated, e.g. with ` + "`nexus_security_realms`" + `.`,
+
+		CreateContext: resourceSecurityCrowdUpdate,
+		ReadContext:   resourceSecurityCrowdRead,
+		UpdateContext: resourceSecurityCrowdUpdate,
+		DeleteContext: resourceSecurityCrowdDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"application_name": {
+				Description: "The name of the application configured in Crowd",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"application_password": {
+				Description: "The password of the application configured in Crowd",
+				Required:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"enabled": {
+				Default:     true,
+				Description: "Whether Crowd support is enabled",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"timeout": {
+				Computed:     true,
+				Description:  "Timeout in milliseconds for requests to Crowd",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"url": {
+				Description:  "The URL of the Crowd server",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"use_trust_store": {
+				Default:     false,
+				Description: "Whether to use certificates stored in Nexus Repository Manager's truststore for the connection to Crowd",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"verify_connection": {
+				Default:     false,
+				Description: "Let Nexus verify the connection to Crowd before the configuration is saved",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func getSecurityCrowdFromResourceData(d *schema.ResourceData) api.CrowdSettings {
+	return api.CrowdSettings{
+		Enabled:             d.Get("enabled").(bool),
+		URL:                 d.Get("url").(string),
+		ApplicationName:     d.Get("application_name").(string),
+		ApplicationPassword: d.Get("application_password").(string),
+		UseTrustStoreForURL: d.Get("use_trust_store").(bool),
+		Timeout:             d.Get("timeout").(int),
+	}
+}
+
+func setSecurityCrowdToResourceData(crowd *api.CrowdSettings, d *schema.ResourceData) {
+	d.SetId("crowd")
+	// d.Set("application_password", crowd.ApplicationPassword) // ApplicationPassword is not returned by API
+	d.Set("application_name", crowd.ApplicationName)
+	d.Set("enabled", crowd.Enabled)
+	d.Set("timeout", crowd.Timeout)
+	d.Set("url", crowd.URL)
+	d.Set("use_trust_store", crowd.UseTrustStoreForURL)
+}
+
+func resourceSecurityCrowdRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	crowd, err := client.GetCrowd(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setSecurityCrowdToResourceData(crowd, d)
+	return nil
+}
+
+func resourceSecurityCrowdUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	current, err := client.GetCrowd(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	crowd := getSecurityCrowdFromResourceData(d)
+	// The realm is managed by nexus_security_realms
+	crowd.RealmActive = current.RealmActive
+
+	if d.Get("verify_connection").(bool) {
+		if err := client.VerifyCrowdConnection(ctx, crowd); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := client.UpdateCrowd(ctx, crowd); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSecurityCrowdRead(ctx, d, m)
+}
+
+func resourceSecurityCrowdDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	crowd, err := client.GetCrowd(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	crowd.ApplicationPassword = d.Get("application_password").(string)
+	crowd.Enabled = false
+
+	if err := client.UpdateCrowd(ctx, *crowd); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
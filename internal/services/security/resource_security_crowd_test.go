@@ -0,0 +1,72 @@
+package security_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceSecurityCrowd() api.CrowdSettings {
+	return api.CrowdSettings{
+		Enabled:             true,
+		URL:                 "https://crowd.example.com",
+		ApplicationName:     fmt.Sprintf("nexus-%s", acctest.RandString(5)),
+		ApplicationPassword: acctest.RandString(16),
+		UseTrustStoreForURL: false,
+		Timeout:             15000,
+	}
+}
+
+func TestAccResourceSecurityCrowd(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resName := "nexus_security_crowd.acceptance"
+	crowd := testAccResourceSecurityCrowd()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityCrowdConfig(crowd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "crowd"),
+					resource.TestCheckResourceAttr(resName, "application_name", crowd.ApplicationName),
+					resource.TestCheckResourceAttr(resName, "application_password", crowd.ApplicationPassword),
+					resource.TestCheckResourceAttr(resName, "enabled", strconv.FormatBool(crowd.Enabled)),
+					resource.TestCheckResourceAttr(resName, "timeout", strconv.Itoa(crowd.Timeout)),
+					resource.TestCheckResourceAttr(resName, "url", crowd.URL),
+					resource.TestCheckResourceAttr(resName, "use_trust_store", strconv.FormatBool(crowd.UseTrustStoreForURL)),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportStateId:           "crowd",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"application_password", "verify_connection"},
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityCrowdConfig(crowd api.CrowdSettings) string {
+	return fmt.Sprintf(`
+resource "nexus_security_crowd" "acceptance" {
+	application_name     = "%s"
+	application_password = "%s"
+	enabled              = %t
+	timeout              = %d
+	url                  = "%s"
+	use_trust_store      = %t
+}
+`, crowd.ApplicationName, crowd.ApplicationPassword, crowd.Enabled, crowd.Timeout, crowd.URL, crowd.UseTrustStoreForURL)
+}
@@ -1,9 +1,15 @@
 package security
 
 import (
+	"context"
+	"fmt"
+
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -12,10 +18,10 @@ func ResourceSecurityLDAP() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus Security LDAP configuration.",
 
-		Create: resourceSecurityLDAPCreate,
-		Read:   resourceSecurityLDAPRead,
-		Update: resourceSecurityLDAPUpdate,
-		Delete: resourceSecurityLDAPDelete,
+		CreateContext: resourceSecurityLDAPCreate,
+		ReadContext:   resourceSecurityLDAPRead,
+		UpdateContext: resourceSecurityLDAPUpdate,
+		DeleteContext: resourceSecurityLDAPDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -177,32 +183,71 @@ func ResourceSecurityLDAP() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
+			"verify": {
+				Description: "Let Nexus verify the configuration before it is saved",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection": {
+							Default:     true,
+							Description: "Verify the connection to the LDAP server",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						"user_mapping": {
+							Default:     true,
+							Description: "Verify that the user and group mapping finds users",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						"login_username": {
+							Description:  "Username of a test user to verify the login with",
+							Optional:     true,
+							RequiredWith: []string{"verify.0.login_password"},
+							Type:         schema.TypeString,
+						},
+						"login_password": {
+							Description:  "Password of the test user to verify the login with",
+							Optional:     true,
+							RequiredWith: []string{"verify.0.login_username"},
+							Sensitive:    true,
+							Type:         schema.TypeString,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
 		},
 	}
 }
 
-func resourceSecurityLDAPCreate(d *schema.ResourceData, m interface{}) error {
+func resourceSecurityLDAPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*nexus.NexusClient)
 
 	ldap := getSecurityLDAPFromResourceData(d)
 
+	if diags := verifySecurityLDAP(ctx, api.NewClient(m), ldap, d); diags.HasError() {
+		return diags
+	}
+
 	if err := client.Security.LDAP.Create(ldap); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := setSecurityLDAPToResourceData(&ldap, d); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceSecurityLDAPRead(d, m)
+	return resourceSecurityLDAPRead(ctx, d, m)
 }
 
-func resourceSecurityLDAPRead(d *schema.ResourceData, m interface{}) error {
+func resourceSecurityLDAPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*nexus.NexusClient)
 
 	ldap, err := client.Security.LDAP.Get(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ldap == nil {
@@ -210,30 +255,129 @@ func resourceSecurityLDAPRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 
-	return setSecurityLDAPToResourceData(ldap, d)
+	return diag.FromErr(setSecurityLDAPToResourceData(ldap, d))
 }
 
-func resourceSecurityLDAPUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceSecurityLDAPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*nexus.NexusClient)
 
 	ldapID := d.Id()
 	ldap := getSecurityLDAPFromResourceData(d)
 
+	if diags := verifySecurityLDAP(ctx, api.NewClient(m), ldap, d); diags.HasError() {
+		return diags
+	}
+
 	if err := client.Security.LDAP.Update(ldapID, ldap); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := setSecurityLDAPToResourceData(&ldap, d); err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	return resourceSecurityLDAPRead(ctx, d, m)
+}
+
+// verifySecurityLDAP runs the checks of the verify block against Nexus and
+// reports failures on the attributes which most likely cause them
+func verifySecurityLDAP(ctx context.Context, client *api.Client, ldap security.LDAP, d *schema.ResourceData) diag.Diagnostics {
+	verifyList := d.Get("verify").([]interface{})
+	if len(verifyList) == 0 || verifyList[0] == nil {
+		return nil
+	}
+	verify := verifyList[0].(map[string]interface{})
+
+	if verify["connection"].(bool) {
+		if err := client.VerifyLDAPConnection(ctx, ldap); err != nil {
+			return diag.Diagnostics{securityLDAPVerifyDiagnostic("LDAP connection verification failed", err, "host")}
+		}
+	}
+
+	var diags diag.Diagnostics
+	username := verify["login_username"].(string)
+
+	if verify["user_mapping"].(bool) {
+		users, err := client.VerifyLDAPUserMapping(ctx, ldap)
+		if err != nil {
+			return append(diags, securityLDAPVerifyDiagnostic("LDAP user mapping verification failed", err, "user_base_dn"))
+		}
+		if len(users) == 0 {
+			return append(diags, securityLDAPVerifyDiagnostic("LDAP user mapping verification failed",
+				fmt.Errorf("no users found below '%s' with object class '%s' and filter '%s'", ldap.UserBaseDN, ldap.UserObjectClass, ldap.UserLDAPFilter),
+				"user_base_dn"))
+		}
+		diags = append(diags, verifySecurityLDAPUsers(ldap, users, username)...)
+	}
+
+	if username != "" {
+		login := api.LDAPLogin{
+			LDAP:     ldap,
+			Username: username,
+			Password: verify["login_password"].(string),
+		}
+		if err := client.VerifyLDAPLogin(ctx, login); err != nil {
+			attribute := "user_password_attribute"
+			if ldap.UserPasswordAttribute == "" {
+				attribute = "user_id_attribute"
+			}
+			diags = append(diags, securityLDAPVerifyDiagnostic("LDAP login verification failed", err, attribute))
+		}
+	}
+
+	return diags
+}
+
+// verifySecurityLDAPUsers checks the users found by the user mapping for the
+// test user and, if LDAP groups are used as roles, for group memberships
+func verifySecurityLDAPUsers(ldap security.LDAP, users []api.LDAPUser, username string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if username != "" {
+		found := false
+		for _, user := range users {
+			if user.Username == username {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diags = append(diags, securityLDAPVerifyDiagnostic("LDAP user mapping verification failed",
+				fmt.Errorf("test user '%s' not found by the user mapping", username), "user_id_attribute"))
+		}
+	}
+
+	if ldap.LDAPGroupsAsRoles {
+		memberships := []string{}
+		for _, user := range users {
+			memberships = append(memberships, user.Membership...)
+		}
+		if len(memberships) == 0 {
+			attribute := "group_member_attribute"
+			if ldap.GroupType == "dynamic" {
+				attribute = "user_member_of_attribute"
+			}
+			diags = append(diags, securityLDAPVerifyDiagnostic("LDAP group mapping verification failed",
+				fmt.Errorf("none of the %d users found is member of a group", len(users)), attribute))
+		}
 	}
 
-	return resourceSecurityLDAPRead(d, m)
+	return diags
+}
+
+func securityLDAPVerifyDiagnostic(summary string, err error, attribute string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        err.Error(),
+		AttributePath: cty.GetAttrPath(attribute),
+	}
 }
 
-func resourceSecurityLDAPDelete(d *schema.ResourceData, m interface{}) error {
+func resourceSecurityLDAPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*nexus.NexusClient)
 
-	return client.Security.LDAP.Delete(d.Id())
+	return diag.FromErr(client.Security.LDAP.Delete(d.Id()))
 }
 
 func setSecurityLDAPToResourceData(ldap *security.LDAP, d *schema.ResourceData) error {
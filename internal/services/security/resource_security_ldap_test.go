@@ -2,6 +2,7 @@ package security_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -84,7 +85,38 @@ func TestAccResourceSecurityLDAP(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityLDAPVerifyConnection(t *testing.T) {
+	ldap := testAccResourceSecurityLDAP()
+	ldap.Name = "acceptance-verify"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// No LDAP server is listening on the configured host
+				Config:      testAccResourceSecurityLDAPVerifyConfig(ldap),
+				ExpectError: regexp.MustCompile("LDAP connection verification failed"),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityLDAPVerifyConfig(ldap security.LDAP) string {
+	return testAccResourceSecurityLDAPConfigWithBlocks(ldap, `
+	verify {
+		connection   = true
+		user_mapping = true
+	}`)
+}
+
 func testAccResourceSecurityLDAPConfig(ldap security.LDAP) string {
+	return testAccResourceSecurityLDAPConfigWithBlocks(ldap, "")
+}
+
+// testAccResourceSecurityLDAPConfigWithBlocks renders the LDAP resource with
+// the given additional blocks
+func testAccResourceSecurityLDAPConfigWithBlocks(ldap security.LDAP, blocks string) string {
 	return fmt.Sprintf(`
 resource "nexus_security_ldap" "%s" {
 	auth_password                  = "%s"
@@ -103,6 +135,7 @@ resource "nexus_security_ldap" "%s" {
 	user_id_attribute              = "%s"
 	user_object_class              = "%s"
 	user_real_name_attribute       = "%s"
+%s
 }
-`, ldap.Name, ldap.AuthPassword, ldap.AuthSchema, ldap.AuthUserName, ldap.ConnectionRetryDelaySeconds, ldap.ConnectionTimeoutSeconds, ldap.GroupType, ldap.Host, ldap.MaxIncidentCount, ldap.Name, ldap.Port, ldap.Protocol, ldap.SearchBase, ldap.UserEmailAddressAttribute, ldap.UserIDAttribute, ldap.UserObjectClass, ldap.UserRealNameAttribute)
+`, ldap.Name, ldap.AuthPassword, ldap.AuthSchema, ldap.AuthUserName, ldap.ConnectionRetryDelaySeconds, ldap.ConnectionTimeoutSeconds, ldap.GroupType, ldap.Host, ldap.MaxIncidentCount, ldap.Name, ldap.Port, ldap.Protocol, ldap.SearchBase, ldap.UserEmailAddressAttribute, ldap.UserIDAttribute, ldap.UserObjectClass, ldap.UserRealNameAttribute, blocks)
 }
@@ -24,7 +24,7 @@ func ResourceSecurityRealms() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"id": common.ResourceID,
 			"active": {
-				Description: "Set the active security realms in the order they should be used.",
+				Description: "Set the active security realms in the order they should be used. The IDs of the available realms are listed by the `nexus_security_realms` data source, e.g. `NpmToken` lets npm clients authenticate with `npm login`.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -35,6 +35,29 @@ func TestAccResourceSecurityRealms(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityRealmsNpmToken(t *testing.T) {
+	resName := "nexus_security_realms.acceptance"
+	realms := []string{"NexusAuthenticatingRealm", "NexusAuthorizingRealm", "NpmToken"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityRealmsConfig(realms),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "active.#", strconv.Itoa(len(realms))),
+					resource.TestCheckResourceAttr(resName, "active.2", "NpmToken"),
+				),
+			},
+			{
+				// Restore the default realms
+				Config: testAccResourceSecurityRealmsConfig(realms[:2]),
+			},
+		},
+	})
+}
+
 func testAccResourceSecurityRealmsConfig(realms []string) string {
 	return fmt.Sprintf(`
 resource "nexus_security_realms" "acceptance" {
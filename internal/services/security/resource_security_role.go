@@ -1,6 +1,7 @@
 package security
 
 import (
+	"fmt"
 	"strings"
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
@@ -10,6 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// securityRoleMutexKV serializes all changes of the same role, because Nexus
+// only supports replacing the whole role document.
+var securityRoleMutexKV = tools.NewMutexKV()
+
 func ResourceSecurityRole() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus Role.",
@@ -114,8 +119,21 @@ func resourceSecurityRoleUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 	roleID := d.Get("roleid").(string)
 
-	role := getSecurityRoleFromResourceData(d)
-	if err := client.Security.Role.Update(roleID, role); err != nil {
+	// Re-read the role and only apply the changes of this resource, so
+	// privileges and roles attached by nexus_security_role_privilege and
+	// nexus_security_role_member are kept
+	if err := modifySecurityRole(client, roleID, func(role *security.Role) {
+		role.Name = d.Get("name").(string)
+		role.Description = d.Get("description").(string)
+		if d.HasChange("privileges") {
+			oldPrivileges, newPrivileges := d.GetChange("privileges")
+			role.Privileges = applySecurityRoleSetChange(role.Privileges, oldPrivileges.(*schema.Set), newPrivileges.(*schema.Set))
+		}
+		if d.HasChange("roles") {
+			oldRoles, newRoles := d.GetChange("roles")
+			role.Roles = applySecurityRoleSetChange(role.Roles, oldRoles.(*schema.Set), newRoles.(*schema.Set))
+		}
+	}); err != nil {
 		return err
 	}
 
@@ -139,3 +157,35 @@ func resourceSecurityRoleExists(d *schema.ResourceData, m interface{}) (bool, er
 	role, err := client.Security.Role.Get(d.Id())
 	return role != nil, err
 }
+
+// modifySecurityRole does a read-modify-write of the role with the given id
+// while holding the lock for that role.
+func modifySecurityRole(client *nexus.NexusClient, roleID string, modify func(role *security.Role)) error {
+	securityRoleMutexKV.Lock(roleID)
+	defer securityRoleMutexKV.Unlock(roleID)
+
+	role, err := client.Security.Role.Get(roleID)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return fmt.Errorf("role '%s' does not exist", roleID)
+	}
+
+	modify(role)
+	return client.Security.Role.Update(roleID, *role)
+}
+
+// applySecurityRoleSetChange removes the values dropped from the set and adds
+// the new ones, keeping all other values Nexus returned
+func applySecurityRoleSetChange(values []string, oldSet *schema.Set, newSet *schema.Set) []string {
+	for _, value := range tools.InterfaceSliceToStringSlice(oldSet.Difference(newSet).List()) {
+		values = tools.RemoveString(values, value)
+	}
+	for _, value := range tools.InterfaceSliceToStringSlice(newSet.Difference(oldSet).List()) {
+		if !tools.ContainsString(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
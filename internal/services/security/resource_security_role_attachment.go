@@ -0,0 +1,100 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// securityRoleAttachment implements the non-authoritative resources, which
+// attach a single value to a list of an existing role
+type securityRoleAttachment struct {
+	// attribute holds the attached value in the resource data
+	attribute string
+	// values returns the list of the role the value is attached to
+	values func(role *security.Role) *[]string
+}
+
+var (
+	securityRolePrivilegeAttachment = securityRoleAttachment{
+		attribute: "privilege",
+		values:    func(role *security.Role) *[]string { return &role.Privileges },
+	}
+	securityRoleMemberAttachment = securityRoleAttachment{
+		attribute: "member_roleid",
+		values:    func(role *security.Role) *[]string { return &role.Roles },
+	}
+)
+
+func (a securityRoleAttachment) create(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	roleID := d.Get("roleid").(string)
+	value := d.Get(a.attribute).(string)
+
+	if err := modifySecurityRole(client, roleID, func(role *security.Role) {
+		if values := a.values(role); !tools.ContainsString(*values, value) {
+			*values = append(*values, value)
+		}
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(securityRoleAttachmentID(roleID, value))
+	return a.read(d, m)
+}
+
+func (a securityRoleAttachment) read(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	roleID, value, err := parseSecurityRoleAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	role, err := client.Security.Role.Get(roleID)
+	if err != nil {
+		return err
+	}
+
+	if role == nil || !tools.ContainsString(*a.values(role), value) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(a.attribute, value)
+	d.Set("roleid", role.ID)
+
+	return nil
+}
+
+func (a securityRoleAttachment) delete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	roleID := d.Get("roleid").(string)
+	value := d.Get(a.attribute).(string)
+
+	if err := modifySecurityRole(client, roleID, func(role *security.Role) {
+		values := a.values(role)
+		*values = tools.RemoveString(*values, value)
+	}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func securityRoleAttachmentID(roleID string, value string) string {
+	return fmt.Sprintf("%s/%s", roleID, value)
+}
+
+func parseSecurityRoleAttachmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of id '%s', expected <roleid>/<value>", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,39 @@
+package security
+
+import (
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityRoleMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to add a single child role to an existing Nexus Role.
+
+This resource is non-authoritative: other child roles of the role are left untouched.
+Do not combine it with the ` + "`roles`" + ` attribute of ` + "`nexus_security_role`" + ` for the same role
+unless that attribute is ignored via ` + "`lifecycle.ignore_changes`" + `.`,
+
+		Create: securityRoleMemberAttachment.create,
+		Read:   securityRoleMemberAttachment.read,
+		Delete: securityRoleMemberAttachment.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"roleid": {
+				Description: "The id of the role to add the child role to.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"member_roleid": {
+				Description: "The id of the child role to add to the role.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecurityRoleMember(t *testing.T) {
+	resName := "nexus_security_role_member.acceptance"
+
+	role := security.Role{
+		ID:          acctest.RandString(10),
+		Name:        acctest.RandString(10),
+		Description: acctest.RandString(30),
+	}
+	memberRoleID := "nx-anonymous"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityRoleMemberConfig(role, memberRoleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", fmt.Sprintf("%s/%s", role.ID, memberRoleID)),
+					resource.TestCheckResourceAttr(resName, "roleid", role.ID),
+					resource.TestCheckResourceAttr(resName, "member_roleid", memberRoleID),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     fmt.Sprintf("%s/%s", role.ID, memberRoleID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityRoleMemberConfig(role security.Role, memberRoleID string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_role" "acceptance" {
+	roleid      = "%s"
+	name        = "%s"
+	description = "%s"
+	privileges  = ["nx-healthcheck-read"]
+
+	lifecycle {
+		ignore_changes = [roles]
+	}
+}
+
+resource "nexus_security_role_member" "acceptance" {
+	roleid        = nexus_security_role.acceptance.roleid
+	member_roleid = "%s"
+}
+`, role.ID, role.Name, role.Description, memberRoleID)
+}
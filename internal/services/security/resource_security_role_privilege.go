@@ -0,0 +1,39 @@
+package security
+
+import (
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityRolePrivilege() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to add a single privilege to an existing Nexus Role.
+
+This resource is non-authoritative: other privileges of the role are left untouched.
+Do not combine it with the ` + "`privileges`" + ` attribute of ` + "`nexus_security_role`" + ` for the same role
+unless that attribute is ignored via ` + "`lifecycle.ignore_changes`" + `.`,
+
+		Create: securityRolePrivilegeAttachment.create,
+		Read:   securityRolePrivilegeAttachment.read,
+		Delete: securityRolePrivilegeAttachment.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"roleid": {
+				Description: "The id of the role to add the privilege to.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"privilege": {
+				Description: "The name of the privilege to add to the role.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
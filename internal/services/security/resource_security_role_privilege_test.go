@@ -0,0 +1,73 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecurityRolePrivilege(t *testing.T) {
+	resName := "nexus_security_role_privilege.acceptance"
+
+	role := security.Role{
+		ID:          acctest.RandString(10),
+		Name:        acctest.RandString(10),
+		Description: acctest.RandString(30),
+	}
+	privilege := "nx-repository-view-*-*-browse"
+	updatedRole := role
+	updatedRole.Description = acctest.RandString(30)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityRolePrivilegeConfig(role, privilege),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", fmt.Sprintf("%s/%s", role.ID, privilege)),
+					resource.TestCheckResourceAttr(resName, "roleid", role.ID),
+					resource.TestCheckResourceAttr(resName, "privilege", privilege),
+				),
+			},
+			// Updating the role must keep the privilege, otherwise the plan
+			// after this step would recreate nexus_security_role_privilege
+			{
+				Config: testAccResourceSecurityRolePrivilegeConfig(updatedRole, privilege),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_security_role.acceptance", "description", updatedRole.Description),
+					resource.TestCheckResourceAttr(resName, "id", fmt.Sprintf("%s/%s", role.ID, privilege)),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     fmt.Sprintf("%s/%s", role.ID, privilege),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityRolePrivilegeConfig(role security.Role, privilege string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_role" "acceptance" {
+	roleid      = "%s"
+	name        = "%s"
+	description = "%s"
+
+	lifecycle {
+		ignore_changes = [privileges]
+	}
+}
+
+resource "nexus_security_role_privilege" "acceptance" {
+	roleid    = nexus_security_role.acceptance.roleid
+	privilege = "%s"
+}
+`, role.ID, role.Name, role.Description, privilege)
+}
@@ -1,6 +1,10 @@
 package security
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
@@ -9,6 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Length in bytes of the random password used for users without password
+const securityUserRandomPasswordLength = 32
+
 func ResourceSecurityUser() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to manage users.",
@@ -46,10 +53,27 @@ func ResourceSecurityUser() *schema.Resource {
 				Required:    true,
 			},
 			"password": {
-				Description: "The password for the user.",
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Description:   "The password for the user. Omit it for users whose credentials are managed elsewhere, they are created with a random password which is not stored in the state.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+			},
+			"password_wo": {
+				Description:   "Password for the user, which is only sent to Nexus on create and whenever `password_wo_version` changes. It is neither stored in the state nor part of the planned values, but saved plan files still contain the configuration as written.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return true
+				},
+			},
+			"password_wo_version": {
+				Description:  "Version of `password_wo`. Change this value to rotate the password of the user.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"roles": {
 				Description: "The roles which the user has been assigned within Nexus.",
@@ -83,10 +107,44 @@ func getSecurityUserFromResourceData(d *schema.ResourceData) security.User {
 	}
 }
 
+// getSecurityUserWriteOnlyPassword returns the value of password_wo from the
+// raw configuration, as it is never part of the plan or state
+func getSecurityUserWriteOnlyPassword(d *schema.ResourceData) string {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return ""
+	}
+
+	password := config.GetAttr("password_wo")
+	if password.IsNull() || !password.IsKnown() {
+		return ""
+	}
+	return password.AsString()
+}
+
+func generateSecurityUserPassword() (string, error) {
+	b := make([]byte, securityUserRandomPasswordLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate random password: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 func resourceSecurityUserCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 	user := getSecurityUserFromResourceData(d)
 
+	if user.Password == "" {
+		user.Password = getSecurityUserWriteOnlyPassword(d)
+	}
+	if user.Password == "" {
+		password, err := generateSecurityUserPassword()
+		if err != nil {
+			return err
+		}
+		user.Password = password
+	}
+
 	if err := client.Security.User.Create(user); err != nil {
 		return err
 	}
@@ -122,7 +180,18 @@ func resourceSecurityUserUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
 	if d.HasChange("password") {
-		password := d.Get("password").(string)
+		if password := d.Get("password").(string); password != "" {
+			if err := client.Security.User.ChangePassword(d.Id(), password); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("password_wo_version") {
+		password := getSecurityUserWriteOnlyPassword(d)
+		if password == "" {
+			return fmt.Errorf("password_wo must be set to change the password of user '%s'", d.Id())
+		}
 		if err := client.Security.User.ChangePassword(d.Id(), password); err != nil {
 			return err
 		}
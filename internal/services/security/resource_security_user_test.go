@@ -1,6 +1,7 @@
 package security_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -59,7 +60,7 @@ func TestAccResourceSecurityUser(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Password is not returned
-				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateVerifyIgnore: []string{"password", "password_wo", "password_wo_version"},
 			},
 		},
 	})
@@ -78,3 +79,108 @@ resource "nexus_security_user" "acceptance" {
 }
 `, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password, user.Status, strings.Join(user.Roles, "\", \""))
 }
+
+func TestAccResourceSecurityUserWriteOnlyPassword(t *testing.T) {
+	resName := "nexus_security_user.acceptance"
+
+	user := testAccResourceSecurityUser()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserWriteOnlyPasswordConfig(user, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					resource.TestCheckNoResourceAttr(resName, "password"),
+					resource.TestCheckResourceAttr(resName, "password_wo", ""),
+					resource.TestCheckResourceAttr(resName, "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserWriteOnlyPasswordConfig(user, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					resource.TestCheckResourceAttr(resName, "password_wo", ""),
+					resource.TestCheckResourceAttr(resName, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityUserWithoutPassword(t *testing.T) {
+	resName := "nexus_security_user.acceptance"
+
+	user := testAccResourceSecurityUser()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserWithoutPasswordConfig(user),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					resource.TestCheckNoResourceAttr(resName, "password"),
+					resource.TestCheckResourceAttr(resName, "status", user.Status),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityUserWriteOnlyPasswordConfig(user security.User, version int) string {
+	return fmt.Sprintf(`
+resource "nexus_security_user" "acceptance" {
+	userid              = "%s"
+	firstname           = "%s"
+	lastname            = "%s"
+	email               = "%s"
+	password_wo         = "%s-%d"
+	password_wo_version = %d
+	status              = "%s"
+	roles               = ["%s"]
+}
+`, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password, version, version, user.Status, strings.Join(user.Roles, "\", \""))
+}
+
+func testAccResourceSecurityUserWithoutPasswordConfig(user security.User) string {
+	return fmt.Sprintf(`
+resource "nexus_security_user" "acceptance" {
+	userid    = "%s"
+	firstname = "%s"
+	lastname  = "%s"
+	email     = "%s"
+	status    = "%s"
+	roles     = ["%s"]
+}
+`, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Status, strings.Join(user.Roles, "\", \""))
+}
+
+func TestAccResourceSecurityUserWriteOnlyPasswordNotStored(t *testing.T) {
+	user := testAccResourceSecurityUser()
+	wd := acceptance.NewWorkingDir(t)
+	acceptance.AccPreCheck(t)
+
+	for _, version := range []int{1, 2} {
+		password := fmt.Sprintf("%s-%d", user.Password, version)
+
+		// The configuration and variables sections of the plan hold the
+		// configuration as written, the provider only controls the values below
+		var plan map[string]json.RawMessage
+		if err := json.Unmarshal(wd.Plan(testAccResourceSecurityUserWriteOnlyPasswordConfig(user, version)), &plan); err != nil {
+			t.Fatalf("could not parse plan: %v", err)
+		}
+		for _, section := range []string{"planned_values", "resource_changes", "prior_state"} {
+			if strings.Contains(string(plan[section]), password) {
+				t.Errorf("password_wo found in %s of the plan for version %d", section, version)
+			}
+		}
+
+		if strings.Contains(string(wd.Apply()), password) {
+			t.Errorf("password_wo found in the state for version %d", version)
+		}
+	}
+}
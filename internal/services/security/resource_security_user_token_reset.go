@@ -0,0 +1,86 @@
+package security
+
+import (
+	"context"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/api"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityUserTokenReset() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to reset the user token of a single user.
+
+The token is reset whenever ` + "`userid`" + ` or ` + "`keepers`" + ` change, resetting the token of a user without a token succeeds.
+The resource is recreated if the user no longer exists. Destroying the resource does not change the token.
+
+The user has to generate a new token afterwards. The resource does not provide the name code and pass code of the new token,
+as the Nexus REST API neither generates user tokens for other users nor returns them.
+The user-token feature must be enabled, e.g. with ` + "`nexus_security_user_token`" + `.`,
+
+		CreateContext: resourceSecurityUserTokenResetCreate,
+		ReadContext:   resourceSecurityUserTokenResetRead,
+		DeleteContext: resourceSecurityUserTokenResetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"userid": {
+				Description: "The id of the user to reset the token of.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger a reset of the user token.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceSecurityUserTokenResetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+	userID := d.Get("userid").(string)
+
+	if err := client.ResetUserToken(ctx, userID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userID)
+	return nil
+}
+
+// resourceSecurityUserTokenResetRead only checks the user, as Nexus does not
+// return user tokens
+func resourceSecurityUserTokenResetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.NewClient(m)
+
+	exists, err := client.UserExists(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("userid", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceSecurityUserTokenResetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
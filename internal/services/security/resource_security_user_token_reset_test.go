@@ -0,0 +1,66 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecurityUserTokenReset(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resName := "nexus_security_user_token_reset.acceptance"
+	user := testAccResourceSecurityUser()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserTokenResetConfig(user, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					resource.TestCheckResourceAttr(resName, "userid", user.UserID),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserTokenResetConfig(user, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "keepers.rotation", "2"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateId:     user.UserID,
+				ImportStateVerify: true,
+				// keepers only exist in the configuration
+				ImportStateVerifyIgnore: []string{"keepers"},
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityUserTokenResetConfig(user security.User, rotation string) string {
+	return testAccResourceSecurityUserConfig(user) + fmt.Sprintf(`
+resource "nexus_security_user_token" "acceptance" {
+	enabled = true
+}
+
+resource "nexus_security_user_token_reset" "acceptance" {
+	userid = nexus_security_user.acceptance.userid
+
+	keepers = {
+		rotation = "%s"
+	}
+
+	depends_on = [nexus_security_user_token.acceptance]
+}
+`, rotation)
+}
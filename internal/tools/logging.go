@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// LogSubsystemBlobstore is the tflog subsystem of the blob store resources
+	LogSubsystemBlobstore = "blobstore"
+)
+
+// SensitiveAttributes returns the names of all attributes marked Sensitive,
+// including the ones of nested blocks
+func SensitiveAttributes(schemaMap map[string]*schema.Schema) []string {
+	var attributes []string
+	for name, s := range schemaMap {
+		if s.Sensitive {
+			attributes = append(attributes, name)
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			for _, attribute := range SensitiveAttributes(elem.Schema) {
+				if !ContainsString(attributes, attribute) {
+					attributes = append(attributes, attribute)
+				}
+			}
+		}
+	}
+	sort.Strings(attributes)
+
+	return attributes
+}
+
+// resourceSensitiveAttributes caches the Sensitive attributes per resource type
+var resourceSensitiveAttributes sync.Map
+
+// NewResourceLogContext returns a context with the given log subsystem, which
+// adds fields to all log entries and masks the values of all Sensitive
+// attributes of the resource type. The attributes are collected from the
+// schema of resource once per resource type.
+func NewResourceLogContext(ctx context.Context, subsystem string, resourceType string, resource func() *schema.Resource, fields map[string]interface{}) context.Context {
+	attributes, ok := resourceSensitiveAttributes.Load(resourceType)
+	if !ok {
+		attributes, _ = resourceSensitiveAttributes.LoadOrStore(resourceType, SensitiveAttributes(resource().Schema))
+	}
+
+	ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", subsystem), tflog.WithRootFields())
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, attributes.([]string)...)
+	for key, value := range fields {
+		ctx = tflog.SubsystemSetField(ctx, subsystem, key, value)
+	}
+
+	return ctx
+}
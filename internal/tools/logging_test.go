@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLoggingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type: schema.TypeString,
+		},
+		"password": {
+			Sensitive: true,
+			Type:      schema.TypeString,
+		},
+		"security": {
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"access_key_id": {
+						Type: schema.TypeString,
+					},
+					"secret_access_key": {
+						Sensitive: true,
+						Type:      schema.TypeString,
+					},
+				},
+			},
+			Type: schema.TypeList,
+		},
+	},
+}
+
+func TestSensitiveAttributes(t *testing.T) {
+	assert.Equal(t, []string{"password", "secret_access_key"}, SensitiveAttributes(testLoggingResource.Schema))
+}
+
+func TestNewResourceLogContext(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = NewResourceLogContext(ctx, "test", "test_resource", func() *schema.Resource { return testLoggingResource }, map[string]interface{}{
+		"name":     "example",
+		"password": "top-secret",
+	})
+
+	tflog.SubsystemDebug(ctx, "test", "read resource", map[string]interface{}{
+		"access_key_id":     "access-key",
+		"secret_access_key": "secret-key",
+	})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"@level":            "debug",
+			"@message":          "read resource",
+			"@module":           "provider.test",
+			"access_key_id":     "access-key",
+			"name":              "example",
+			"password":          "***",
+			"secret_access_key": "***",
+		},
+	}, entries)
+}
+
+func TestNewResourceLogContextCollectsAttributesOnce(t *testing.T) {
+	calls := 0
+	resource := func() *schema.Resource {
+		calls++
+		return testLoggingResource
+	}
+
+	NewResourceLogContext(context.Background(), "test", "test_resource_once", resource, nil)
+	NewResourceLogContext(context.Background(), "test", "test_resource_once", resource, nil)
+	assert.Equal(t, 1, calls)
+}
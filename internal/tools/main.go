@@ -68,3 +68,24 @@ func ConvertStringSet(set *schema.Set) []string {
 
 	return s
 }
+
+// ContainsString reports whether value is present in values
+func ContainsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveString returns a copy of values without any occurrence of value
+func RemoveString(values []string, value string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -47,3 +47,18 @@ func TestConvertStringSet(t *testing.T) {
 
 	assert.Equal(t, testStrings, convertedSet)
 }
+
+func TestContainsString(t *testing.T) {
+	values := []string{"foo", "bar"}
+
+	assert.True(t, ContainsString(values, "bar"))
+	assert.False(t, ContainsString(values, "baz"))
+	assert.False(t, ContainsString(nil, "foo"))
+}
+
+func TestRemoveString(t *testing.T) {
+	values := []string{"foo", "bar", "foo"}
+
+	assert.Equal(t, []string{"bar"}, RemoveString(values, "foo"))
+	assert.Equal(t, values, RemoveString(values, "baz"))
+}
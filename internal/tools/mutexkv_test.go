@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutexKVSerializesSameKey(t *testing.T) {
+	mutexKV := NewMutexKV()
+	counter := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mutexKV.Lock("role")
+			defer mutexKV.Unlock("role")
+			current := counter
+			counter = current + 1
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, counter)
+}
+
+func TestMutexKVIndependentKeys(t *testing.T) {
+	mutexKV := NewMutexKV()
+
+	mutexKV.Lock("foo")
+	done := make(chan struct{})
+	go func() {
+		mutexKV.Lock("bar")
+		mutexKV.Unlock("bar")
+		close(done)
+	}()
+	<-done
+	mutexKV.Unlock("foo")
+}
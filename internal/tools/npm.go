@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// NpmScopeRegexp matches npm scope names with or without the leading @
+var NpmScopeRegexp = regexp.MustCompile(`^@?[a-z0-9~-][a-z0-9._~-]*$`)
+
+// GetNpmScopeRoutingMatchers returns the routing rule matchers of the request
+// paths of all packages within the given npm scopes. npm clients request the
+// metadata of scoped packages with an encoded slash (/@scope%2fname) and their
+// tarballs with a plain one (/@scope/name/-/name-1.0.0.tgz), so every scope
+// gets a matcher for both.
+//
+// ^/@scope/.* matches the tarball paths of the scope no matter which group
+// member serves them. A BLOCK rule with these matchers on the proxy is only
+// correct if the hosted repository serves all packages of these scopes,
+// otherwise the group can no longer resolve their tarballs.
+func GetNpmScopeRoutingMatchers(scopes []string) ([]string, error) {
+	matchers := make([]string, 0, 2*len(scopes))
+	for _, scope := range scopes {
+		if !NpmScopeRegexp.MatchString(scope) {
+			return nil, fmt.Errorf("invalid npm scope '%s'", scope)
+		}
+		quotedScope := regexp.QuoteMeta(strings.TrimPrefix(scope, "@"))
+		matchers = append(matchers,
+			fmt.Sprintf("^/@%s/.*", quotedScope),
+			fmt.Sprintf("^/@%s%%2[fF].*", quotedScope),
+		)
+	}
+	return matchers, nil
+}
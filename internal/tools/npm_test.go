@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNpmScopeRoutingMatchers(t *testing.T) {
+	matchers, err := GetNpmScopeRoutingMatchers([]string{"@company", "my.team"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"^/@company/.*",
+		"^/@company%2[fF].*",
+		"^/@my\\.team/.*",
+		"^/@my\\.team%2[fF].*",
+	}, matchers)
+}
+
+func TestGetNpmScopeRoutingMatchersMatchRequestPaths(t *testing.T) {
+	matchers, err := GetNpmScopeRoutingMatchers([]string{"company"})
+	assert.NoError(t, err)
+
+	matches := func(path string) bool {
+		for _, matcher := range matchers {
+			if regexp.MustCompile(matcher).MatchString(path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.True(t, matches("/@company%2fui"))
+	assert.True(t, matches("/@company%2Fui"))
+	assert.True(t, matches("/@company/ui/-/ui-1.0.0.tgz"))
+	assert.False(t, matches("/@company-other%2fui"))
+	assert.False(t, matches("/@other/ui/-/ui-1.0.0.tgz"))
+	assert.False(t, matches("/lodash"))
+}
+
+func TestGetNpmScopeRoutingMatchersInvalidScope(t *testing.T) {
+	_, err := GetNpmScopeRoutingMatchers([]string{"@Company/ui"})
+	assert.EqualError(t, err, "invalid npm scope '@Company/ui'")
+}
@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+// PGPKey contains the public parts of a PGP key pair used by Nexus to sign
+// repository metadata
+type PGPKey struct {
+	// Fingerprint is the upper case hex encoded fingerprint of the primary key
+	Fingerprint string
+	// KeyID is the upper case hex encoded long key ID of the primary key
+	KeyID string
+	// PublicKey is the armored public key, which clients need to verify the
+	// signatures
+	PublicKey string
+}
+
+// ReadPGPPrivateKey reads an armored PGP private key as exported by
+// gpg --export-secret-key --armor. The key may still be encrypted.
+func ReadPGPPrivateKey(armored string) (*openpgp.Entity, error) {
+	block, err := armor.Decode(strings.NewReader(armored))
+	if err != nil {
+		return nil, fmt.Errorf("invalid armored PGP key: %v", err)
+	}
+	if block.Type != openpgp.PrivateKeyType {
+		return nil, fmt.Errorf("armored PGP key is a '%s', expected a '%s'", block.Type, openpgp.PrivateKeyType)
+	}
+
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armored))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PGP private key: %v", err)
+	}
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("armored PGP key contains %d keys, expected exactly one", len(entities))
+	}
+	if entities[0].PrivateKey == nil {
+		return nil, fmt.Errorf("armored PGP key contains no private key")
+	}
+	return entities[0], nil
+}
+
+// GetPGPKey reads an armored PGP private key, checks that the passphrase
+// decrypts it and all of its subkeys and returns its public parts
+func GetPGPKey(armored string, passphrase string) (*PGPKey, error) {
+	entity, err := ReadPGPPrivateKey(armored)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decryptPGPPrivateKey(entity.PrivateKey, passphrase); err != nil {
+		return nil, fmt.Errorf("passphrase does not decrypt the PGP private key: %v", err)
+	}
+	// Nexus may sign with any of the subkeys, so all of them must be usable
+	for _, subkey := range entity.Subkeys {
+		if err := decryptPGPPrivateKey(subkey.PrivateKey, passphrase); err != nil {
+			return nil, fmt.Errorf("passphrase does not decrypt the PGP private subkey %016X: %v", subkey.PublicKey.KeyId, err)
+		}
+	}
+
+	var publicKey bytes.Buffer
+	w, err := armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.Serialize(w); err != nil {
+		return nil, fmt.Errorf("could not serialize PGP public key: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	publicKey.WriteString("\n")
+
+	return &PGPKey{
+		Fingerprint: fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint),
+		KeyID:       fmt.Sprintf("%016X", entity.PrimaryKey.KeyId),
+		PublicKey:   publicKey.String(),
+	}, nil
+}
+
+// decryptPGPPrivateKey decrypts the private key if it is encrypted
+func decryptPGPPrivateKey(privateKey *packet.PrivateKey, passphrase string) error {
+	if privateKey == nil || !privateKey.Encrypted {
+		return nil
+	}
+	if passphrase == "" {
+		return fmt.Errorf("the key is encrypted, but no passphrase is given")
+	}
+	return privateKey.Decrypt([]byte(passphrase))
+}
@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testPGPPassphrase  = "test-passphrase"
+	testPGPFingerprint = "84F20A2BF84A8CAD587B526358785B3F8341C981"
+	testPGPKeyID       = "58785B3F8341C981"
+)
+
+// readTestPGPPrivateKey reads the key pair of the acceptance tests, which can
+// not be imported here without an import cycle
+func readTestPGPPrivateKey(t *testing.T) string {
+	armored, err := os.ReadFile("../acceptance/testdata/pgp-private-key.asc")
+	assert.NoError(t, err)
+	return string(armored)
+}
+
+func TestGetPGPKey(t *testing.T) {
+	key, err := GetPGPKey(readTestPGPPrivateKey(t), testPGPPassphrase)
+	assert.NoError(t, err)
+	assert.Equal(t, testPGPFingerprint, key.Fingerprint)
+	assert.Equal(t, testPGPKeyID, key.KeyID)
+
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key.PublicKey))
+	assert.NoError(t, err)
+	assert.Len(t, entities, 1)
+	assert.Nil(t, entities[0].PrivateKey)
+	assert.Equal(t, testPGPKeyID, fmt.Sprintf("%016X", entities[0].PrimaryKey.KeyId))
+}
+
+func TestGetPGPKeyWrongPassphrase(t *testing.T) {
+	_, err := GetPGPKey(readTestPGPPrivateKey(t), "wrong-passphrase")
+	assert.ErrorContains(t, err, "passphrase does not decrypt the PGP private key")
+
+	_, err = GetPGPKey(readTestPGPPrivateKey(t), "")
+	assert.ErrorContains(t, err, "no passphrase is given")
+}
+
+func TestGetPGPKeySubkeyPassphrase(t *testing.T) {
+	entity, err := openpgp.NewEntity("Subkey Test", "", "subkey@example.com", nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, entity.Subkeys)
+	assert.NoError(t, entity.PrivateKey.Encrypt([]byte(testPGPPassphrase)))
+	for _, subkey := range entity.Subkeys {
+		assert.NoError(t, subkey.PrivateKey.Encrypt([]byte("other-passphrase")))
+	}
+
+	var armored bytes.Buffer
+	w, err := armor.Encode(&armored, openpgp.PrivateKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.SerializePrivateWithoutSigning(w, nil))
+	assert.NoError(t, w.Close())
+
+	_, err = GetPGPKey(armored.String(), testPGPPassphrase)
+	assert.ErrorContains(t, err, "passphrase does not decrypt the PGP private subkey")
+}
+
+func TestReadPGPPrivateKeyInvalid(t *testing.T) {
+	_, err := ReadPGPPrivateKey("test-keypair")
+	assert.ErrorContains(t, err, "invalid armored PGP key")
+
+	key, err := GetPGPKey(readTestPGPPrivateKey(t), testPGPPassphrase)
+	assert.NoError(t, err)
+	_, err = ReadPGPPrivateKey(key.PublicKey)
+	assert.ErrorContains(t, err, "expected a 'PGP PRIVATE KEY BLOCK'")
+}